@@ -0,0 +1,67 @@
+package iterator
+
+import (
+	"time"
+
+	"github.com/sidkurella/goption/option"
+)
+
+// An iterator that groups elements into batches, bounded in both size and latency.
+type batchTimeoutIterator[T any] struct {
+	timedSource[T]
+	n       uint64
+	maxWait time.Duration
+}
+
+// BatchTimeout creates an iterator that groups the elements of inner into batches of at most n elements.
+// A batch is yielded once it has n elements, or once maxWait has elapsed since its first element arrived, whichever
+// comes first; the final batch may be smaller. Each batch is a freshly allocated slice. If clock is nil,
+// SystemClock is used. Panics if n is 0.
+//
+// Elements are pulled from inner on a background goroutine, which may pull one element ahead of the batch being
+// built. Defer Close, as with FromSeq, if the iterator may not be consumed to exhaustion.
+// If inner is a FallibleIterator, its error is reported by Err.
+func BatchTimeout[T any](inner Iterator[T], n uint64, maxWait time.Duration, clock Clock) *batchTimeoutIterator[T] {
+	if n == 0 {
+		panic("iterator: batch size must be non-zero")
+	}
+	return &batchTimeoutIterator[T]{
+		timedSource: newTimedSource(inner, clock),
+		n:           n,
+		maxWait:     maxWait,
+	}
+}
+
+func (b *batchTimeoutIterator[T]) Next() option.Option[[]T] {
+	if b.done {
+		return option.Nothing[[]T]()
+	}
+	f := b.start()
+	item, open := <-f.items
+	if !open {
+		b.finish()
+		return option.Nothing[[]T]()
+	}
+	batch := []T{f.unwrap(item)}
+	timer := b.clock.After(b.maxWait)
+	for uint64(len(batch)) < b.n {
+		select {
+		case item, open := <-f.items:
+			if !open {
+				b.finish()
+				return option.Some(batch)
+			}
+			batch = append(batch, f.unwrap(item))
+		case <-timer:
+			// Include an element that is already waiting, rather than leave it for the next batch.
+			val, ok, open := f.tryRecv()
+			if ok {
+				batch = append(batch, val)
+			} else if !open {
+				b.finish()
+			}
+			return option.Some(batch)
+		}
+	}
+	return option.Some(batch)
+}
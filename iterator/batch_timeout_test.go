@@ -0,0 +1,65 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+func TestBatchTimeout(t *testing.T) {
+	t.Run("by size", func(t *testing.T) {
+		iter := iterator.BatchTimeout[int](sliceutil.Iter([]int{1, 2, 3, 4, 5}), 2, time.Hour, newFakeClock(false))
+		defer iter.Close()
+		actual := iterator.Collect[[]int](iter)
+		if !reflect.DeepEqual(actual, [][]int{{1, 2}, {3, 4}, {5}}) {
+			t.Fail()
+		}
+		if iter.Err() != nil {
+			t.Fail()
+		}
+	})
+	t.Run("by time", func(t *testing.T) {
+		clock := newFakeClock(false)
+		ch := make(chan int, 1)
+		ch <- 1
+		iter := iterator.BatchTimeout[int](iterator.FromChan(ch), 5, time.Second, clock)
+		defer iter.Close()
+		results := make(chan option.Option[[]int])
+		go func() {
+			results <- iter.Next()
+		}()
+		clock.WaitForCalls(1)
+		clock.Advance(time.Second)
+		if !reflect.DeepEqual(<-results, option.Some([]int{1})) {
+			t.Fail()
+		}
+		ch <- 2
+		close(ch)
+		if !reflect.DeepEqual(iter.Next(), option.Some([]int{2})) {
+			t.Fail()
+		}
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("closes inner when exhausted", func(t *testing.T) {
+		source := &fakeCloseableIterator{fakeIterator: fakeIterator{elements: []int{1}}}
+		iter := iterator.BatchTimeout[int](source, 2, time.Hour, newFakeClock(false))
+		iterator.Collect[[]int](iter)
+		if !source.closed {
+			t.Fail()
+		}
+	})
+	t.Run("close before start", func(t *testing.T) {
+		source := &fakeCloseableIterator{fakeIterator: fakeIterator{elements: []int{1}}}
+		iter := iterator.BatchTimeout[int](source, 2, time.Hour, newFakeClock(false))
+		iter.Close()
+		if !source.closed || iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+}
@@ -4,17 +4,19 @@ import "github.com/sidkurella/goption/option"
 
 // Implements an iterator that delivers all elements from first, then second once first is exhausted.
 type chainIterator[T any] struct {
-	first          Iterator[T]
-	second         Iterator[T]
-	firstExhausted bool
+	first           Iterator[T]
+	second          Iterator[T]
+	firstExhausted  bool // Whether first has been drained, from either end.
+	secondExhausted bool // Whether second has been drained, from either end.
 }
 
 // Returns a new iterator that delivers all elements from first, then second once first is exhausted.
 func Chain[T any](first Iterator[T], second Iterator[T]) *chainIterator[T] {
 	return &chainIterator[T]{
-		first:          first,
-		second:         second,
-		firstExhausted: false,
+		first:           first,
+		second:          second,
+		firstExhausted:  false,
+		secondExhausted: false,
 	}
 }
 
@@ -22,11 +24,62 @@ func Chain[T any](first Iterator[T], second Iterator[T]) *chainIterator[T] {
 func (c *chainIterator[T]) Next() option.Option[T] {
 	if !c.firstExhausted {
 		firstValue := c.first.Next()
-		if firstValue.IsNothing() {
-			c.firstExhausted = true
-		} else {
+		if firstValue.IsSome() {
 			return firstValue
 		}
+		c.firstExhausted = true
 	}
-	return c.second.Next()
+	if !c.secondExhausted {
+		secondValue := c.second.Next()
+		if secondValue.IsSome() {
+			return secondValue
+		}
+		c.secondExhausted = true
+	}
+	return option.Nothing[T]()
+}
+
+// Returns the next item from the back of the iterator. If the second iterator is empty, returns the next element
+// from the back of the first.
+// Either side that is not a DoubleEndedIterator has its remaining elements buffered first, unless it has already
+// been drained from the front.
+func (c *chainIterator[T]) NextBack() option.Option[T] {
+	if !c.secondExhausted {
+		secondValue := backOf(&c.second).NextBack()
+		if secondValue.IsSome() {
+			return secondValue
+		}
+		c.secondExhausted = true
+	}
+	if !c.firstExhausted {
+		firstValue := backOf(&c.first).NextBack()
+		if firstValue.IsSome() {
+			return firstValue
+		}
+		c.firstExhausted = true
+	}
+	return option.Nothing[T]()
+}
+
+func (c *chainIterator[T]) buffersBack() bool {
+	return (!c.secondExhausted && mayBufferBack(c.second)) || (!c.firstExhausted && mayBufferBack(c.first))
+}
+
+// Returns the bounds on the remaining length, which is the sum of both iterators' bounds.
+func (c *chainIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	firstLower, firstUpper := uint64(0), option.Some(uint64(0))
+	if !c.firstExhausted {
+		firstLower, firstUpper = SizeHint(c.first)
+	}
+	secondLower, secondUpper := uint64(0), option.Some(uint64(0))
+	if !c.secondExhausted {
+		secondLower, secondUpper = SizeHint(c.second)
+	}
+	return saturatingAdd(firstLower, secondLower), addUpper(firstUpper, secondUpper)
+}
+
+// Close closes both the first and second iterators, for those that implement Closer.
+func (c *chainIterator[T]) Close() {
+	closeIter(c.first)
+	closeIter(c.second)
 }
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/sliceutil"
 )
 
 func TestChain(t *testing.T) {
@@ -42,3 +44,69 @@ func TestChain_FirstEmpty(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestChain_NextBack(t *testing.T) {
+	expected := []int{30, 20, 10, 3, 2, 1}
+	iter := iterator.Chain[int](sliceutil.Iter([]int{1, 2, 3}), sliceutil.Iter([]int{10, 20, 30}))
+	actual := iterator.Collect[int](iterator.Rev[int](iter))
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fail()
+	}
+}
+
+func TestChain_BothEnds(t *testing.T) {
+	i1 := &fakeIterator{
+		elements: []int{1, 2},
+	}
+	iter := iterator.Chain[int](i1, sliceutil.Iter([]int{10, 20}))
+	expected := []int{1, 20, 2, 10}
+	actual := []int{
+		iter.Next().Unwrap(),
+		iter.NextBack().Unwrap(),
+		iter.Next().Unwrap(),
+		iter.NextBack().Unwrap(),
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fail()
+	}
+	if iter.Next().IsSome() || iter.NextBack().IsSome() {
+		t.Fail()
+	}
+}
+
+// pastEndIterator counts the calls to Next made after it has returned Nothing.
+type pastEndIterator struct {
+	fakeIterator
+	ended   bool
+	pastEnd int
+}
+
+func (p *pastEndIterator) Next() option.Option[int] {
+	if p.ended {
+		p.pastEnd++
+	}
+	ret := p.fakeIterator.Next()
+	p.ended = ret.IsNothing()
+	return ret
+}
+
+func TestChain_DrainedFromEitherEnd(t *testing.T) {
+	first := &pastEndIterator{fakeIterator: fakeIterator{elements: []int{1, 2}}}
+	second := &pastEndIterator{fakeIterator: fakeIterator{elements: []int{3, 4}}}
+	iter := iterator.Chain[int](first, second)
+	actual := []int{
+		iter.Next().Unwrap(),
+		iter.Next().Unwrap(),
+		iter.Next().Unwrap(), // Drains first from the front.
+		iter.NextBack().Unwrap(),
+	}
+	if !reflect.DeepEqual(actual, []int{1, 2, 3, 4}) {
+		t.Fatalf("got %v", actual)
+	}
+	if iter.NextBack().IsSome() || iter.Next().IsSome() || iter.NextBack().IsSome() {
+		t.Fail()
+	}
+	if first.pastEnd != 0 || second.pastEnd != 0 {
+		t.Errorf("drained sources were pulled again: first %v, second %v", first.pastEnd, second.pastEnd)
+	}
+}
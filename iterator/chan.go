@@ -0,0 +1,104 @@
+package iterator
+
+import (
+	"context"
+
+	"github.com/sidkurella/goption/option"
+)
+
+// An iterator that receives its elements from a channel.
+type chanIterator[T any] struct {
+	ctx  context.Context
+	ch   <-chan T
+	done bool
+	err  error
+}
+
+// FromChan creates an iterator that receives elements from ch until it is closed.
+// Next blocks until an element is available.
+func FromChan[T any](ch <-chan T) *chanIterator[T] {
+	return FromChanContext(context.Background(), ch)
+}
+
+// FromChanContext creates an iterator that receives elements from ch until it is closed or ctx is done.
+// Next blocks until an element is available or ctx is done. If ctx is done first, the iterator returns Nothing
+// from then on, and Err reports the context's error.
+//
+// The channel is owned by its sender, so Close does not close it; Close only stops the iterator from receiving
+// further elements.
+func FromChanContext[T any](ctx context.Context, ch <-chan T) *chanIterator[T] {
+	return &chanIterator[T]{
+		ctx:  ctx,
+		ch:   ch,
+		done: false,
+		err:  nil,
+	}
+}
+
+func (c *chanIterator[T]) Next() option.Option[T] {
+	if c.done {
+		return option.Nothing[T]()
+	}
+	// Check for cancellation first, since select chooses randomly among ready cases.
+	if err := c.ctx.Err(); err != nil {
+		c.done, c.err = true, err
+		return option.Nothing[T]()
+	}
+	select {
+	case val, ok := <-c.ch:
+		if !ok {
+			c.done = true
+			return option.Nothing[T]()
+		}
+		return option.Some(val)
+	case <-c.ctx.Done():
+		c.done, c.err = true, c.ctx.Err()
+		return option.Nothing[T]()
+	}
+}
+
+// Err returns the context's error if the iterator stopped because its context was done. Returns nil otherwise.
+func (c *chanIterator[T]) Err() error {
+	return c.err
+}
+
+// Close stops the iterator. After Close is called, Next will return Nothing.
+// It is safe to call Close multiple times or after the iterator is exhausted.
+func (c *chanIterator[T]) Close() {
+	c.done = true
+}
+
+// ToChan starts a goroutine that sends each element of iter on the returned channel, which has a buffer of
+// bufSize elements. The channel is closed once iter is exhausted or ctx is done.
+//
+// The goroutine takes ownership of iter: no other goroutine may use it afterwards. If iter implements Closer,
+// it is closed when the goroutine finishes.
+//
+// A consumer that stops receiving before the channel is closed must cancel ctx, or the goroutine will block
+// forever on its next send. Elements already buffered in the channel when ctx is done are not drained.
+func ToChan[T any](ctx context.Context, iter Iterator[T], bufSize int) <-chan T {
+	ch := make(chan T, max(bufSize, 0))
+	go func() {
+		defer close(ch)
+		if closer, ok := iter.(Closer); ok {
+			defer closer.Close()
+		}
+		for {
+			// Check for cancellation before pulling the next element, since select chooses randomly among ready
+			// cases and would keep sending while the channel has room.
+			if ctx.Err() != nil {
+				return
+			}
+			item := iter.Next()
+			if item.IsNothing() {
+				return
+			}
+			select {
+			case ch <- item.Unwrap():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
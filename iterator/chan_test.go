@@ -0,0 +1,103 @@
+package iterator_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	iter := iterator.FromChan(ch)
+	expected := []int{1, 2, 3}
+	actual := iterator.Collect[int](iter)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+	if iter.Err() != nil {
+		t.Fail()
+	}
+}
+
+func TestFromChanContext(t *testing.T) {
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := make(chan int, 1)
+		ch <- 1
+		iter := iterator.FromChanContext(ctx, ch)
+		if iter.Next().Unwrap() != 1 {
+			t.Fail()
+		}
+		cancel()
+		if iter.Next().IsSome() { // Would block forever without cancellation.
+			t.Fail()
+		}
+		if !errors.Is(iter.Err(), context.Canceled) {
+			t.Fail()
+		}
+	})
+	t.Run("closed", func(t *testing.T) {
+		ch := make(chan int, 1)
+		ch <- 1
+		iter := iterator.FromChanContext(context.Background(), ch)
+		iter.Close()
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+}
+
+func TestToChan(t *testing.T) {
+	t.Run("all elements", func(t *testing.T) {
+		ch := iterator.ToChan[int](context.Background(), iterator.Range(0, 5), 2)
+		actual := []int{}
+		for v := range ch {
+			actual = append(actual, v)
+		}
+		if !reflect.DeepEqual([]int{0, 1, 2, 3, 4}, actual) {
+			t.Fail()
+		}
+	})
+	t.Run("stops early", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		it := iterator.FromSeq(slices.Values([]int{1, 2, 3, 4, 5}))
+		ch := iterator.ToChan[int](ctx, it, 0)
+		if <-ch != 1 {
+			t.Fail()
+		}
+		cancel()
+		for range ch { // The channel is closed once the goroutine observes the cancellation.
+		}
+		if it.Next().IsSome() { // The source was closed by the goroutine.
+			t.Fail()
+		}
+	})
+	t.Run("cancelled stops consumption", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		pulled := 0
+		it := iterator.Inspect[int](iterator.Range(0, 1000), func(int) { pulled++ })
+		ch := iterator.ToChan[int](ctx, it, 1000)
+		for range ch {
+		}
+		if pulled != 0 { // Closing ch orders the goroutine's writes to pulled before this read.
+			t.Errorf("expected no elements to be pulled, got %v", pulled)
+		}
+	})
+	t.Run("round trip", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		iter := iterator.FromChanContext(ctx, iterator.ToChan[int](ctx, iterator.Range(0, 100), 8))
+		if iterator.Sum[int](iter) != 4950 {
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package iterator
+
+import "github.com/sidkurella/goption/option"
+
+type chunkByIterator[T any, K comparable] struct {
+	inner   Iterator[T]
+	keyFn   func(T) K
+	pending option.Option[T] // The first element of the next chunk, if it has already been pulled.
+}
+
+// Creates an iterator that groups consecutive elements for which keyFn returns equal keys.
+// Each yielded chunk is a freshly allocated, non-empty slice. Elements with equal keys that are not adjacent
+// are placed in separate chunks.
+func ChunkBy[T any, K comparable](inner Iterator[T], keyFn func(T) K) *chunkByIterator[T, K] {
+	return &chunkByIterator[T, K]{
+		inner:   inner,
+		keyFn:   keyFn,
+		pending: option.Nothing[T](),
+	}
+}
+
+func (c *chunkByIterator[T, K]) Next() option.Option[[]T] {
+	first, ok := c.pending.OrElse(c.inner.Next).Get()
+	if !ok {
+		return option.Nothing[[]T]()
+	}
+	c.pending = option.Nothing[T]()
+	key := c.keyFn(first)
+	chunk := []T{first}
+	for item := c.inner.Next(); item.IsSome(); item = c.inner.Next() {
+		val := item.Unwrap()
+		if c.keyFn(val) != key {
+			c.pending = item
+			break
+		}
+		chunk = append(chunk, val)
+	}
+	return option.Some(chunk)
+}
+
+// Close closes the inner iterator if it implements Closer, and discards any element already pulled from it.
+func (c *chunkByIterator[T, K]) Close() {
+	closeIter(c.inner)
+	c.pending = option.Nothing[T]()
+}
@@ -0,0 +1,29 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+func TestChunkBy(t *testing.T) {
+	t.Run("chunks", func(t *testing.T) {
+		iter := iterator.ChunkBy[int](&fakeIterator{elements: []int{1, 3, 2, 4, 6, 5, 7}}, func(i int) bool {
+			return i%2 == 0
+		})
+		expected := [][]int{{1, 3}, {2, 4, 6}, {5, 7}}
+		actual := iterator.Collect[[]int](iter)
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fail()
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		iter := iterator.ChunkBy[int](&fakeIterator{}, func(i int) int {
+			return i
+		})
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,128 @@
+package iterator
+
+import "github.com/sidkurella/goption/option"
+
+type chunksIterator[T any] struct {
+	inner Iterator[T]
+	n     uint64
+	done  bool
+}
+
+// Creates an iterator that yields the elements of inner in batches of n.
+// The final batch may have fewer than n elements if the iterator does not divide evenly.
+// Each batch is a freshly allocated slice.
+// Panics if n is 0.
+func Chunks[T any](inner Iterator[T], n uint64) *chunksIterator[T] {
+	if n == 0 {
+		panic("iterator: chunk size must be non-zero")
+	}
+	return &chunksIterator[T]{
+		inner: inner,
+		n:     n,
+		done:  false,
+	}
+}
+
+func (c *chunksIterator[T]) Next() option.Option[[]T] {
+	if c.done {
+		return option.Nothing[[]T]()
+	}
+	chunk, full := takeChunk(c.inner, c.n)
+	c.done = !full
+	if len(chunk) == 0 {
+		return option.Nothing[[]T]()
+	}
+	return option.Some(chunk)
+}
+
+// Returns the bounds on the remaining number of chunks.
+func (c *chunksIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	if c.done {
+		return exactSizeHint(0)
+	}
+	chunks := func(l uint64) uint64 {
+		return l/c.n + min(l%c.n, 1)
+	}
+	lower, upper := SizeHint(c.inner)
+	return chunks(lower), option.Map(upper, chunks)
+}
+
+type chunksExactIterator[T any] struct {
+	inner     Iterator[T]
+	n         uint64
+	done      bool
+	remainder []T
+}
+
+// Creates an iterator that yields the elements of inner in batches of exactly n.
+// If the iterator does not divide evenly, the final elements are not yielded; they are available from Remainder
+// once the iterator has returned Nothing.
+// Each batch is a freshly allocated slice.
+// Panics if n is 0.
+func ChunksExact[T any](inner Iterator[T], n uint64) *chunksExactIterator[T] {
+	if n == 0 {
+		panic("iterator: chunk size must be non-zero")
+	}
+	return &chunksExactIterator[T]{
+		inner:     inner,
+		n:         n,
+		done:      false,
+		remainder: nil,
+	}
+}
+
+func (c *chunksExactIterator[T]) Next() option.Option[[]T] {
+	if c.done {
+		return option.Nothing[[]T]()
+	}
+	chunk, full := takeChunk(c.inner, c.n)
+	if !full {
+		c.done = true
+		c.remainder = chunk
+		return option.Nothing[[]T]()
+	}
+	return option.Some(chunk)
+}
+
+// Remainder returns the elements left over after the last full chunk, which has fewer than n elements.
+// It is empty until the iterator has returned Nothing.
+func (c *chunksExactIterator[T]) Remainder() []T {
+	return c.remainder
+}
+
+// Returns the bounds on the remaining number of full chunks.
+func (c *chunksExactIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	if c.done {
+		return exactSizeHint(0)
+	}
+	chunks := func(l uint64) uint64 {
+		return l / c.n
+	}
+	lower, upper := SizeHint(c.inner)
+	return chunks(lower), option.Map(upper, chunks)
+}
+
+// takeChunk pulls up to n elements from iter into a new slice.
+// Returns the slice and whether all n elements were available.
+// Only as many elements as iter is known to have are preallocated, so that a large n does not allocate up front.
+func takeChunk[T any](iter Iterator[T], n uint64) ([]T, bool) {
+	chunk := make([]T, 0, min(n, uint64(capacityHint(iter))))
+	for uint64(len(chunk)) < n {
+		val, ok := iter.Next().Get()
+		if !ok {
+			return chunk, false
+		}
+		chunk = append(chunk, val)
+	}
+	return chunk, true
+}
+
+// Close closes the inner iterator if it implements Closer.
+func (c *chunksIterator[T]) Close() {
+	closeIter(c.inner)
+}
+
+// Close closes the inner iterator if it implements Closer.
+func (c *chunksExactIterator[T]) Close() {
+	closeIter(c.inner)
+}
@@ -0,0 +1,62 @@
+package iterator_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+func TestChunks(t *testing.T) {
+	t.Run("uneven", func(t *testing.T) {
+		iter := iterator.Chunks[int](iterator.Range(0, 7), 3)
+		lower, _ := iterator.SizeHint[[]int](iter)
+		if lower != 3 {
+			t.Fail()
+		}
+		expected := [][]int{{0, 1, 2}, {3, 4, 5}, {6}}
+		actual := iterator.Collect[[]int](iter)
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fail()
+		}
+	})
+	t.Run("even", func(t *testing.T) {
+		iter := iterator.Chunks[int](&fakeIterator{elements: []int{1, 2, 3, 4}}, 2)
+		expected := [][]int{{1, 2}, {3, 4}}
+		actual := iterator.Collect[[]int](iter)
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fail()
+		}
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		iter := iterator.Chunks[int](&fakeIterator{}, 2)
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("huge size", func(t *testing.T) {
+		iter := iterator.Chunks[int](iterator.Range(0, 3), math.MaxUint64)
+		if !reflect.DeepEqual(iterator.Collect[[]int](iter), [][]int{{0, 1, 2}}) {
+			t.Fail()
+		}
+	})
+}
+
+func TestChunksExact(t *testing.T) {
+	iter := iterator.ChunksExact[int](iterator.Range(0, 7), 3)
+	if len(iter.Remainder()) != 0 {
+		t.Fail()
+	}
+	expected := [][]int{{0, 1, 2}, {3, 4, 5}}
+	actual := iterator.Collect[[]int](iter)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(iter.Remainder(), []int{6}) {
+		t.Fail()
+	}
+}
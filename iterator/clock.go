@@ -0,0 +1,178 @@
+package iterator
+
+import (
+	"context"
+	"time"
+)
+
+// Clock is the source of time for the time-based adapters, such as Throttle and Timeout.
+// Substituting a fake clock makes those adapters deterministic in tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After returns a channel that receives the current time once d has elapsed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// SystemClock is the Clock backed by the time package. It is used by the time-based adapters when given a nil Clock.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// clockOrSystem returns clock, or SystemClock if clock is nil.
+func clockOrSystem(clock Clock) Clock {
+	if clock == nil {
+		return SystemClock
+	}
+	return clock
+}
+
+// An element pulled from an iterator by a feeder, or a panic raised while pulling it.
+type feedItem[T any] struct {
+	val      T
+	panicked bool
+	panicVal any
+}
+
+// feeder pulls elements from an iterator on a background goroutine, so that waiting for them can be combined with
+// timers. The goroutine owns the iterator: it closes the iterator, if it implements Closer, once it is exhausted
+// or the feeder is stopped.
+type feeder[T any] struct {
+	items  chan feedItem[T]
+	cancel context.CancelFunc
+	err    error // The iterator's error, if it is a FallibleIterator. Only valid once items is closed.
+}
+
+func startFeeder[T any](inner Iterator[T]) *feeder[T] {
+	ctx, cancel := context.WithCancel(context.Background())
+	f := &feeder[T]{
+		items:  make(chan feedItem[T]),
+		cancel: cancel,
+		err:    nil,
+	}
+	go func() {
+		defer close(f.items)
+		defer closeIter(inner)
+		defer func() {
+			if r := recover(); r != nil {
+				select {
+				case f.items <- feedItem[T]{panicked: true, panicVal: r}:
+				case <-ctx.Done():
+				}
+			}
+		}()
+		for {
+			val, ok := inner.Next().Get()
+			if !ok {
+				f.err = errOf(inner)
+				return
+			}
+			select {
+			case f.items <- feedItem[T]{val: val}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return f
+}
+
+// unwrap returns the item's element, re-raising the panic it carries, if any, after stopping the feeder.
+func (f *feeder[T]) unwrap(item feedItem[T]) T {
+	if item.panicked {
+		f.cancel()
+		panic(item.panicVal)
+	}
+	return item.val
+}
+
+// tryRecv receives an element if one is ready, without blocking.
+// Returns false for ok if none is ready, and false for open if the feeder has finished.
+func (f *feeder[T]) tryRecv() (val T, ok bool, open bool) {
+	select {
+	case item, open := <-f.items:
+		if !open {
+			return val, false, false
+		}
+		return f.unwrap(item), true, true
+	default:
+		return val, false, true
+	}
+}
+
+// stop tells the feeder's goroutine to exit. It does not wait for it, since the goroutine may be blocked inside the
+// iterator's Next; the goroutine closes the iterator once that call returns.
+func (f *feeder[T]) stop() {
+	f.cancel()
+}
+
+// timedSource is the state shared by the adapters that wait for their inner iterator with a timer.
+// The feeder is only started on the first call to Next, so that nothing is pulled from the inner iterator earlier.
+type timedSource[T any] struct {
+	inner  Iterator[T]
+	clock  Clock
+	feeder *feeder[T]
+	done   bool
+	err    error
+}
+
+func newTimedSource[T any](inner Iterator[T], clock Clock) timedSource[T] {
+	return timedSource[T]{
+		inner:  inner,
+		clock:  clockOrSystem(clock),
+		feeder: nil,
+		done:   false,
+		err:    nil,
+	}
+}
+
+// start returns the feeder, starting it if needed.
+func (s *timedSource[T]) start() *feeder[T] {
+	if s.feeder == nil {
+		s.feeder = startFeeder(s.inner)
+	}
+	return s.feeder
+}
+
+// finish marks the source as done once the feeder has finished, taking the inner iterator's error.
+func (s *timedSource[T]) finish() {
+	s.done = true
+	s.err = s.feeder.err
+}
+
+// fail marks the source as done because of err, stopping the feeder.
+func (s *timedSource[T]) fail(err error) {
+	s.done = true
+	s.err = err
+	s.feeder.stop()
+}
+
+// Err returns the error that stopped the iterator, or the inner iterator's error if it is a FallibleIterator.
+// Returns nil otherwise.
+func (s *timedSource[T]) Err() error {
+	return s.err
+}
+
+// Close stops the iterator and closes the inner iterator if it implements Closer.
+// If a call to the inner iterator's Next is in progress on the background goroutine, Close does not wait for it;
+// the inner iterator is closed once it returns.
+// After Close is called, Next will return Nothing. It is safe to call Close multiple times.
+func (s *timedSource[T]) Close() {
+	if s.done {
+		return
+	}
+	s.done = true
+	if s.feeder == nil {
+		closeIter(s.inner)
+		return
+	}
+	s.feeder.stop()
+}
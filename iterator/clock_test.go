@@ -0,0 +1,90 @@
+package iterator_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+// fakeClock is a Clock whose time only moves when advanced.
+// If auto is set, After advances the time by the requested duration and fires immediately.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	auto    bool
+	calls   int // The number of calls to After.
+	waiters []fakeWaiter
+}
+
+type fakeWaiter struct {
+	deadline time.Time
+	ch       chan time.Time
+}
+
+var _ iterator.Clock = &fakeClock{}
+
+func newFakeClock(auto bool) *fakeClock {
+	return &fakeClock{
+		now:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		auto: auto,
+	}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls++
+	ch := make(chan time.Time, 1)
+	if c.auto {
+		c.now = c.now.Add(d)
+		ch <- c.now
+		return ch
+	}
+	c.waiters = append(c.waiters, fakeWaiter{deadline: c.now.Add(d), ch: ch})
+	return ch
+}
+
+// Advance moves the time forward by d, firing the timers that are due.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	pending := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.deadline.After(c.now) {
+			pending = append(pending, w)
+		} else {
+			w.ch <- c.now
+		}
+	}
+	c.waiters = pending
+}
+
+// WaitForCalls blocks until After has been called at least n times in total.
+func (c *fakeClock) WaitForCalls(n int) {
+	for {
+		c.mu.Lock()
+		calls := c.calls
+		c.mu.Unlock()
+		if calls >= n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSystemClock(t *testing.T) {
+	before := time.Now()
+	<-iterator.SystemClock.After(time.Millisecond)
+	if iterator.SystemClock.Now().Sub(before) < time.Millisecond {
+		t.Fail()
+	}
+}
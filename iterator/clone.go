@@ -0,0 +1,13 @@
+package iterator
+
+// Cloner is implemented by iterators that can cheaply copy their position, such as those returned by Range and
+// FromSlice.
+// Clone returns an independent iterator over the same remaining elements: advancing either iterator does not
+// affect the other. The elements themselves are not copied, so clones of an iterator over a slice still share
+// its backing array.
+//
+// Iterators that do not implement Cloner can be consumed more than once with Tee.
+type Cloner[T any] interface {
+	Iterator[T]
+	Clone() Iterator[T]
+}
@@ -0,0 +1,134 @@
+package iterator
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/sidkurella/goption/option"
+)
+
+// An iterator over the k-element combinations of a slice, in lexicographic order of position.
+type combinationsIterator[T any] struct {
+	data    []T
+	idx     []int // The positions in data of the elements of the next combination.
+	replace bool  // Whether elements may be repeated within a combination.
+	started bool
+	done    bool
+	count   countdown
+}
+
+// Combinations creates an iterator over the k-element combinations of data, in lexicographic order of position.
+// Each combination is yielded as a freshly allocated slice, with elements in the order they appear in data.
+// Elements are treated as distinct by position, not value, so data with repeated values yields repeated combinations.
+// If k is greater than len(data), the iterator is empty; if k is 0, it yields one empty slice.
+// data is not copied, so it must not be modified while the iterator is in use.
+func Combinations[T any](data []T, k uint64) *combinationsIterator[T] {
+	n := uint64(len(data))
+	total, exact := binomial(n, k)
+	return newCombinationsIterator(data, k, false, k > n, total, exact)
+}
+
+// CombinationsWithReplacement creates an iterator over the k-element combinations of data in which each element
+// may be chosen more than once, in lexicographic order of position. Each combination is yielded as a freshly
+// allocated slice. If data is empty and k is not 0, the iterator is empty; if k is 0, it yields one empty slice.
+// data is not copied, so it must not be modified while the iterator is in use.
+func CombinationsWithReplacement[T any](data []T, k uint64) *combinationsIterator[T] {
+	n := uint64(len(data))
+	total, exact := uint64(0), true
+	switch {
+	case k == 0:
+		total = 1
+	case n > 0 && n-1 <= math.MaxUint64-k:
+		total, exact = binomial(n+k-1, k)
+	case n > 0:
+		total, exact = math.MaxUint64, false
+	}
+	return newCombinationsIterator(data, k, true, n == 0 && k > 0, total, exact)
+}
+
+func newCombinationsIterator[T any](
+	data []T, k uint64, replace bool, empty bool, total uint64, exact bool,
+) *combinationsIterator[T] {
+	c := &combinationsIterator[T]{
+		data:    data,
+		idx:     nil,
+		replace: replace,
+		started: false,
+		done:    empty,
+		count:   countdown{left: total, exact: exact},
+	}
+	if !empty {
+		c.idx = make([]int, k)
+		if !replace {
+			for i := range c.idx {
+				c.idx[i] = i
+			}
+		}
+	}
+	return c
+}
+
+func (c *combinationsIterator[T]) Next() option.Option[[]T] {
+	if c.done {
+		return option.Nothing[[]T]()
+	}
+	if !c.started {
+		c.started = true
+	} else if !c.advance() {
+		c.done = true
+		c.count = countdown{left: 0, exact: true}
+		return option.Nothing[[]T]()
+	}
+	c.count.dec()
+	ret := make([]T, len(c.idx))
+	for i, j := range c.idx {
+		ret[i] = c.data[j]
+	}
+	return option.Some(ret)
+}
+
+// advance moves idx to the next combination. Returns false if there is none.
+func (c *combinationsIterator[T]) advance() bool {
+	n, k := len(c.data), len(c.idx)
+	for i := k - 1; i >= 0; i-- {
+		if c.replace && c.idx[i] != n-1 {
+			c.idx[i]++
+			for j := i + 1; j < k; j++ {
+				c.idx[j] = c.idx[i]
+			}
+			return true
+		}
+		if !c.replace && c.idx[i] != i+n-k {
+			c.idx[i]++
+			for j := i + 1; j < k; j++ {
+				c.idx[j] = c.idx[j-1] + 1
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the bounds on the remaining length, which is known exactly unless it does not fit in a uint64.
+func (c *combinationsIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	return c.count.hint()
+}
+
+// binomial returns the number of ways to choose k of n elements.
+// Returns false if the result does not fit in a uint64.
+func binomial(n uint64, k uint64) (uint64, bool) {
+	if k > n {
+		return 0, true
+	}
+	k = min(k, n-k)
+	ret := uint64(1)
+	for i := range k {
+		// ret is C(n, i), so ret * (n-i) is exactly divisible by i+1.
+		hi, lo := bits.Mul64(ret, n-i)
+		if hi >= i+1 {
+			return math.MaxUint64, false
+		}
+		ret, _ = bits.Div64(hi, lo, i+1)
+	}
+	return ret, true
+}
@@ -0,0 +1,97 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+)
+
+// collectCheckingHint collects the iterator, checking that before each call to Next its size hint is exactly the
+// number of elements remaining.
+func collectCheckingHint[T any](t *testing.T, iter iterator.Iterator[T]) []T {
+	t.Helper()
+	var ret []T
+	var hints []uint64
+	for {
+		lower, upper := iterator.SizeHint(iter)
+		if upper != option.Some(lower) {
+			t.Errorf("expected exact size hint, got (%v, %v)", lower, upper)
+		}
+		hints = append(hints, lower)
+		val, ok := iter.Next().Get()
+		if !ok {
+			break
+		}
+		ret = append(ret, val)
+	}
+	for i, hint := range hints {
+		if hint != uint64(len(ret)-i) {
+			t.Errorf("size hint before element %v: expected %v, got %v", i, len(ret)-i, hint)
+		}
+	}
+	return ret
+}
+
+func TestCombinations(t *testing.T) {
+	t.Run("k of n", func(t *testing.T) {
+		iter := iterator.Combinations([]int{1, 2, 3, 4}, 2)
+		lower, upper := iter.SizeHint()
+		if lower != 6 || upper != option.Some[uint64](6) {
+			t.Fail()
+		}
+		expected := [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}
+		if !reflect.DeepEqual(collectCheckingHint[[]int](t, iter), expected) {
+			t.Fail()
+		}
+	})
+	t.Run("k of 0", func(t *testing.T) {
+		if !reflect.DeepEqual(iterator.Collect[[]int](iterator.Combinations([]int{1, 2}, 0)), [][]int{{}}) {
+			t.Fail()
+		}
+	})
+	t.Run("k greater than n", func(t *testing.T) {
+		iter := iterator.Combinations([]int{1, 2}, 3)
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("fresh slices", func(t *testing.T) {
+		iter := iterator.Combinations([]int{1, 2, 3}, 2)
+		first := iter.Next().Unwrap()
+		iter.Next()
+		if !reflect.DeepEqual(first, []int{1, 2}) {
+			t.Fail()
+		}
+	})
+	t.Run("overflowing size hint", func(t *testing.T) {
+		iter := iterator.Combinations(make([]int, 100), 50)
+		lower, upper := iter.SizeHint()
+		if lower == 0 || upper.IsSome() {
+			t.Fail()
+		}
+	})
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	t.Run("k of n", func(t *testing.T) {
+		iter := iterator.CombinationsWithReplacement([]string{"a", "b", "c"}, 2)
+		lower, upper := iter.SizeHint()
+		if lower != 6 || upper != option.Some[uint64](6) {
+			t.Fail()
+		}
+		expected := [][]string{{"a", "a"}, {"a", "b"}, {"a", "c"}, {"b", "b"}, {"b", "c"}, {"c", "c"}}
+		if !reflect.DeepEqual(collectCheckingHint[[]string](t, iter), expected) {
+			t.Fail()
+		}
+	})
+	t.Run("empty data", func(t *testing.T) {
+		if iterator.CombinationsWithReplacement([]int{}, 1).Next().IsSome() {
+			t.Fail()
+		}
+		if iterator.Count[[]int](iterator.CombinationsWithReplacement([]int{}, 0)) != 1 {
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package iterator
+
+import "cmp"
+
+// Eq returns whether two iterators yield equal elements in the same order, and have the same length.
+// Short-circuits at the first difference.
+func Eq[T comparable](a Iterator[T], b Iterator[T]) bool {
+	return EqBy(a, b, func(t1 T, t2 T) bool {
+		return t1 == t2
+	})
+}
+
+// EqBy returns whether two iterators have the same length and eq returns true for each pair of elements in turn.
+// Short-circuits at the first difference.
+func EqBy[T any, U any](a Iterator[T], b Iterator[U], eq func(T, U) bool) bool {
+	return CmpBy(a, b, func(t T, u U) int {
+		if eq(t, u) {
+			return 0
+		}
+		return 1
+	}) == 0
+}
+
+// Cmp lexicographically compares the elements of two iterators.
+// Returns -1 if a is less than b, 0 if they are equal, and +1 if a is greater than b.
+// If one iterator is a prefix of the other, the shorter iterator is less.
+// Short-circuits at the first difference.
+func Cmp[T cmp.Ordered](a Iterator[T], b Iterator[T]) int {
+	return CmpBy(a, b, cmp.Compare[T])
+}
+
+// CmpBy lexicographically compares the elements of two iterators with respect to compare.
+// compare(t, u) should return a negative number if t < u, zero if t == u, and a positive number if t > u.
+// Returns the first non-zero result of compare, normalized to -1 or +1. If one iterator is a prefix of the other,
+// the shorter iterator is less. Returns 0 if the iterators are equal.
+// Short-circuits at the first difference.
+func CmpBy[T any, U any](a Iterator[T], b Iterator[U], compare func(T, U) int) int {
+	for {
+		t, tOk := a.Next().Get()
+		u, uOk := b.Next().Get()
+		switch {
+		case !tOk && !uOk:
+			return 0
+		case !tOk:
+			return -1
+		case !uOk:
+			return 1
+		}
+		if c := compare(t, u); c != 0 {
+			return cmp.Compare(c, 0)
+		}
+	}
+}
+
+// Lt returns whether a is lexicographically less than b.
+func Lt[T cmp.Ordered](a Iterator[T], b Iterator[T]) bool {
+	return Cmp(a, b) < 0
+}
+
+// Le returns whether a is lexicographically less than or equal to b.
+func Le[T cmp.Ordered](a Iterator[T], b Iterator[T]) bool {
+	return Cmp(a, b) <= 0
+}
+
+// Gt returns whether a is lexicographically greater than b.
+func Gt[T cmp.Ordered](a Iterator[T], b Iterator[T]) bool {
+	return Cmp(a, b) > 0
+}
+
+// Ge returns whether a is lexicographically greater than or equal to b.
+func Ge[T cmp.Ordered](a Iterator[T], b Iterator[T]) bool {
+	return Cmp(a, b) >= 0
+}
+
+// IsSorted returns whether the elements of the iterator are in ascending order.
+// Short-circuits at the first element that is out of order. The empty iterator is sorted.
+func IsSorted[T cmp.Ordered](iter Iterator[T]) bool {
+	return IsSortedBy(iter, cmp.Compare[T])
+}
+
+// IsSortedBy returns whether the elements of the iterator are in ascending order with respect to compare.
+// compare(a, b) should return a negative number if a < b, zero if a == b, and a positive number if a > b.
+// Short-circuits at the first element that is out of order. The empty iterator is sorted.
+func IsSortedBy[T any](iter Iterator[T], compare func(T, T) int) bool {
+	prev, ok := iter.Next().Get()
+	if !ok {
+		return true
+	}
+	return All(iter, func(t T) bool {
+		sorted := compare(prev, t) <= 0
+		prev = t
+		return sorted
+	})
+}
+
+// IsSortedByKey returns whether the keys of the elements of the iterator are in ascending order.
+// key is called exactly once per element examined.
+// Short-circuits at the first element that is out of order. The empty iterator is sorted.
+func IsSortedByKey[T any, K cmp.Ordered](iter Iterator[T], key func(T) K) bool {
+	return IsSorted[K](Map(iter, key))
+}
@@ -0,0 +1,131 @@
+package iterator_test
+
+import (
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+func TestEq(t *testing.T) {
+	if !iterator.Eq[int](iterator.Range(0, 3), sliceutil.Iter([]int{0, 1, 2})) {
+		t.Fail()
+	}
+	if iterator.Eq[int](iterator.Range(0, 3), sliceutil.Iter([]int{0, 1})) {
+		t.Fail()
+	}
+	if iterator.Eq[int](iterator.Range(0, 3), sliceutil.Iter([]int{0, 5, 2})) {
+		t.Fail()
+	}
+	if !iterator.Eq[int](&fakeIterator{}, &fakeIterator{}) {
+		t.Fail()
+	}
+}
+
+func TestEqBy(t *testing.T) {
+	eq := iterator.EqBy[int, string](iterator.Range(1, 4), sliceutil.Iter([]string{"a", "bb", "ccc"}),
+		func(i int, s string) bool {
+			return len(s) == i
+		},
+	)
+	if !eq {
+		t.Fail()
+	}
+}
+
+func TestCmp(t *testing.T) {
+	tests := []struct {
+		a        []int
+		b        []int
+		expected int
+	}{
+		{a: []int{1, 2, 3}, b: []int{1, 2, 3}, expected: 0},
+		{a: []int{1, 2}, b: []int{1, 2, 3}, expected: -1},
+		{a: []int{1, 3}, b: []int{1, 2, 3}, expected: 1},
+		{a: []int{}, b: []int{}, expected: 0},
+	}
+	for _, tt := range tests {
+		if actual := iterator.Cmp[int](sliceutil.Iter(tt.a), sliceutil.Iter(tt.b)); actual != tt.expected {
+			t.Errorf("Cmp(%v, %v): expected %v, got %v", tt.a, tt.b, tt.expected, actual)
+		}
+	}
+}
+
+func TestCmp_ShortCircuits(t *testing.T) {
+	a := sliceutil.Iter([]int{1, 5, 6})
+	b := sliceutil.Iter([]int{1, 2, 7})
+	if iterator.Cmp[int](a, b) != 1 {
+		t.Fail()
+	}
+	if a.Next().Unwrap() != 6 || b.Next().Unwrap() != 7 {
+		t.Fail()
+	}
+}
+
+func TestCmpBy(t *testing.T) {
+	actual := iterator.CmpBy[string, string](
+		sliceutil.Iter([]string{"a", "bbb"}), sliceutil.Iter([]string{"x", "yy"}),
+		func(s1 string, s2 string) int {
+			return len(s1) - len(s2)
+		},
+	)
+	if actual != 1 {
+		t.Fail()
+	}
+}
+
+func TestOrderings(t *testing.T) {
+	less := func() (*fakeIterator, *fakeIterator) {
+		return &fakeIterator{elements: []int{1, 2}}, &fakeIterator{elements: []int{1, 3}}
+	}
+	if a, b := less(); !iterator.Lt[int](a, b) {
+		t.Fail()
+	}
+	if a, b := less(); !iterator.Le[int](a, b) {
+		t.Fail()
+	}
+	if a, b := less(); iterator.Gt[int](a, b) {
+		t.Fail()
+	}
+	if a, b := less(); iterator.Ge[int](a, b) {
+		t.Fail()
+	}
+	if !iterator.Le[int](iterator.Range(0, 2), iterator.Range(0, 2)) {
+		t.Fail()
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	if !iterator.IsSorted[int](sliceutil.Iter([]int{1, 2, 2, 5})) {
+		t.Fail()
+	}
+	iter := sliceutil.Iter([]int{1, 3, 2, 5})
+	if iterator.IsSorted[int](iter) {
+		t.Fail()
+	}
+	if iter.Next().Unwrap() != 5 { // Short-circuits at the out of order element.
+		t.Fail()
+	}
+	if !iterator.IsSorted[int](&fakeIterator{}) {
+		t.Fail()
+	}
+}
+
+func TestIsSortedBy(t *testing.T) {
+	descending := func(a int, b int) int {
+		return b - a
+	}
+	if !iterator.IsSortedBy[int](iterator.RangeBy(5, 0, -1), descending) {
+		t.Fail()
+	}
+	if iterator.IsSortedBy[int](iterator.Range(0, 5), descending) {
+		t.Fail()
+	}
+}
+
+func TestIsSortedByKey(t *testing.T) {
+	iter := sliceutil.Iter([]string{"a", "bb", "cc", "ddd"})
+	if !iterator.IsSortedByKey[string](iter, func(s string) int { return len(s) }) {
+		t.Fail()
+	}
+}
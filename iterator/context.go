@@ -0,0 +1,60 @@
+package iterator
+
+import (
+	"context"
+
+	"github.com/sidkurella/goption/option"
+)
+
+// An iterator that stops once its context is done.
+type contextIterator[T any] struct {
+	ctx   context.Context
+	inner Iterator[T]
+	err   error
+}
+
+// WithContext creates an iterator that yields the elements of iter until ctx is done.
+// The context is checked before each element is pulled from iter; once it is done, Next returns Nothing from then
+// on, and Err reports the context's error. A call to Next that is already blocked inside iter is not interrupted.
+// If the inner iterator is a FallibleIterator, its error is reported by Err as well.
+func WithContext[T any](ctx context.Context, iter Iterator[T]) *contextIterator[T] {
+	return &contextIterator[T]{
+		ctx:   ctx,
+		inner: iter,
+		err:   nil,
+	}
+}
+
+func (c *contextIterator[T]) Next() option.Option[T] {
+	if c.err != nil {
+		return option.Nothing[T]()
+	}
+	if err := c.ctx.Err(); err != nil {
+		c.err = err
+		return option.Nothing[T]()
+	}
+	return c.inner.Next()
+}
+
+// Err returns the context's error if the iterator stopped because its context was done, or the inner iterator's
+// error if it is a FallibleIterator. Returns nil otherwise.
+func (c *contextIterator[T]) Err() error {
+	if c.err != nil {
+		return c.err
+	}
+	return errOf(c.inner)
+}
+
+// Returns the bounds on the remaining length. The context may be done at any time, so the lower bound is 0.
+func (c *contextIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	if c.err != nil {
+		return exactSizeHint(0)
+	}
+	_, upper := SizeHint(c.inner)
+	return 0, upper
+}
+
+// Close closes the inner iterator if it implements Closer.
+func (c *contextIterator[T]) Close() {
+	closeIter(c.inner)
+}
@@ -0,0 +1,43 @@
+package iterator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+func TestWithContext(t *testing.T) {
+	t.Run("not cancelled", func(t *testing.T) {
+		iter := iterator.WithContext[int](context.Background(), iterator.Range(0, 3))
+		if iterator.Count[int](iter) != 3 {
+			t.Fail()
+		}
+		if iter.Err() != nil {
+			t.Fail()
+		}
+	})
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		iter := iterator.WithContext[int](ctx, iterator.Repeat(1))
+		if iter.Next().IsNothing() {
+			t.Fail()
+		}
+		cancel()
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+		if !errors.Is(iter.Err(), context.Canceled) {
+			t.Fail()
+		}
+	})
+	t.Run("inner error", func(t *testing.T) {
+		inner := &fakeFallibleIterator{fakeIterator: fakeIterator{elements: []int{1}}, err: errFake}
+		iter := iterator.WithContext[int](context.Background(), inner)
+		iterator.Collect[int](iter)
+		if !errors.Is(iter.Err(), errFake) {
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package iterator
+
+import (
+	"github.com/sidkurella/goption/option"
+)
+
+type controlFlowVariant int
+
+const (
+	controlFlowVariantContinue controlFlowVariant = iota
+	controlFlowVariantBreak
+)
+
+// ControlFlow tells a fold whether to continue with a new accumulator of type C, or to stop early with a value of
+// type B.
+// The default value is Continue(*new(C)) (i.e. Continue variant containing the zero value of C).
+type ControlFlow[B any, C any] struct {
+	variant controlFlowVariant
+	brk     B
+	cont    C
+}
+
+// Continue returns a ControlFlow that tells the fold to carry on with the given accumulator.
+func Continue[B any, C any](c C) ControlFlow[B, C] {
+	return ControlFlow[B, C]{
+		variant: controlFlowVariantContinue,
+		cont:    c,
+	}
+}
+
+// Break returns a ControlFlow that tells the fold to stop with the given value.
+func Break[B any, C any](b B) ControlFlow[B, C] {
+	return ControlFlow[B, C]{
+		variant: controlFlowVariantBreak,
+		brk:     b,
+	}
+}
+
+// Returns true if the control flow is Continue.
+func (c ControlFlow[B, C]) IsContinue() bool {
+	return c.variant == controlFlowVariantContinue
+}
+
+// Returns true if the control flow is Break.
+func (c ControlFlow[B, C]) IsBreak() bool {
+	return c.variant == controlFlowVariantBreak
+}
+
+// Returns the Continue value, if any.
+func (c ControlFlow[B, C]) ContinueValue() option.Option[C] {
+	if c.IsContinue() {
+		return option.Some(c.cont)
+	}
+	return option.Nothing[C]()
+}
+
+// Returns the Break value, if any.
+func (c ControlFlow[B, C]) BreakValue() option.Option[B] {
+	if c.IsBreak() {
+		return option.Some(c.brk)
+	}
+	return option.Nothing[B]()
+}
@@ -0,0 +1,34 @@
+package iterator_test
+
+import (
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+func TestControlFlow(t *testing.T) {
+	t.Run("continue", func(t *testing.T) {
+		c := iterator.Continue[string](3)
+		if !c.IsContinue() || c.IsBreak() {
+			t.Fail()
+		}
+		if c.ContinueValue().Unwrap() != 3 || c.BreakValue().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("break", func(t *testing.T) {
+		c := iterator.Break[string, int]("done")
+		if c.IsContinue() || !c.IsBreak() {
+			t.Fail()
+		}
+		if c.BreakValue().Unwrap() != "done" || c.ContinueValue().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("default", func(t *testing.T) {
+		var c iterator.ControlFlow[string, int]
+		if c.ContinueValue().Unwrap() != 0 {
+			t.Fail()
+		}
+	})
+}
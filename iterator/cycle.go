@@ -0,0 +1,70 @@
+package iterator
+
+import (
+	"math"
+
+	"github.com/sidkurella/goption/option"
+)
+
+type cycleIterator[T any] struct {
+	inner    Iterator[T]
+	buf      []T  // The elements seen during the first pass.
+	replay   int  // The index in buf of the next element to replay.
+	replayed bool // Whether inner has been exhausted and elements are being replayed from buf.
+}
+
+// Creates an iterator that repeats the elements of inner endlessly.
+// The first pass pulls from inner and buffers each element; later passes replay the buffer.
+// If inner is empty, the cycle is empty too.
+func Cycle[T any](inner Iterator[T]) *cycleIterator[T] {
+	return &cycleIterator[T]{
+		inner:    inner,
+		buf:      nil,
+		replay:   0,
+		replayed: false,
+	}
+}
+
+func (c *cycleIterator[T]) Next() option.Option[T] {
+	if !c.replayed {
+		val, ok := c.inner.Next().Get()
+		if ok {
+			c.buf = append(c.buf, val)
+			return option.Some(val)
+		}
+		c.replayed = true
+	}
+	if len(c.buf) == 0 {
+		return option.Nothing[T]()
+	}
+	ret := c.buf[c.replay]
+	c.replay = (c.replay + 1) % len(c.buf)
+	return option.Some(ret)
+}
+
+// Returns the bounds on the remaining length.
+// A cycle over any elements is endless; a cycle over an empty iterator has no elements.
+func (c *cycleIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	if len(c.buf) > 0 {
+		return math.MaxUint64, option.Nothing[uint64]()
+	}
+	if c.replayed {
+		return exactSizeHint(0)
+	}
+	lower, upper := SizeHint(c.inner)
+	if lower > 0 {
+		return math.MaxUint64, option.Nothing[uint64]()
+	}
+	if upper == option.Some(uint64(0)) {
+		return exactSizeHint(0)
+	}
+	return 0, option.Nothing[uint64]()
+}
+
+// Close closes the inner iterator if it implements Closer, and stops the cycle.
+// After Close is called, Next will return Nothing.
+func (c *cycleIterator[T]) Close() {
+	closeIter(c.inner)
+	c.buf = nil
+	c.replayed = true
+}
@@ -0,0 +1,25 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+func TestCycle(t *testing.T) {
+	t.Run("cycle", func(t *testing.T) {
+		iter := iterator.Cycle[int](&fakeIterator{elements: []int{1, 2, 3}})
+		expected := []int{1, 2, 3, 1, 2, 3, 1}
+		actual := iterator.Collect[int](iterator.Take[int](iter, 7))
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fail()
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		iter := iterator.Cycle[int](&fakeIterator{})
+		if iter.Next().IsSome() || iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package iterator
+
+import (
+	"time"
+
+	"github.com/sidkurella/goption/option"
+)
+
+// An iterator over a range of times.
+type dateRangeIterator struct {
+	advance   func(i int, prev time.Time) time.Time // Returns the element at index i, given the one before it.
+	cur       time.Time                             // The next element, if it is within the range.
+	end       time.Time
+	ascending bool
+	i         int // The index of cur.
+	done      bool
+}
+
+// DateRange returns an iterator over the times from start (inclusive) to end (exclusive), stepping by step.
+// Each element is the previous one plus step. A negative step ranges backwards, in which case the iterator is empty
+// unless end is before start. Panics if step is 0.
+func DateRange(start time.Time, end time.Time, step time.Duration) *dateRangeIterator {
+	if step == 0 {
+		panic("iterator: date range step must be non-zero")
+	}
+	return &dateRangeIterator{
+		advance: func(_ int, prev time.Time) time.Time {
+			return prev.Add(step) // Durations are integers, so repeated addition is exact.
+		},
+		cur:       start,
+		end:       end,
+		ascending: step > 0,
+		i:         0,
+		done:      false,
+	}
+}
+
+// DateRangeCalendar returns an iterator over the times from start (inclusive) to end (exclusive), stepping by a
+// calendar period of the given years, months and days. The ith element is computed as
+// start.AddDate(i*years, i*months, i*days) rather than by repeated addition, so that normalization does not
+// accumulate: as with time.Time.AddDate, January 31st plus one month is normalized to March 2nd or 3rd, but the
+// element after that is March 31st, not April 2nd or 3rd.
+// The direction of the range is that of the first step. Panics if the step does not move start.
+func DateRangeCalendar(start time.Time, end time.Time, years int, months int, days int) *dateRangeIterator {
+	first := start.AddDate(years, months, days)
+	if first.Equal(start) {
+		panic("iterator: date range step must be non-zero")
+	}
+	return &dateRangeIterator{
+		advance: func(i int, _ time.Time) time.Time {
+			return start.AddDate(i*years, i*months, i*days)
+		},
+		cur:       start,
+		end:       end,
+		ascending: first.After(start),
+		i:         0,
+		done:      false,
+	}
+}
+
+func (d *dateRangeIterator) Next() option.Option[time.Time] {
+	if d.done {
+		return option.Nothing[time.Time]()
+	}
+	ret := d.cur
+	if (d.ascending && !ret.Before(d.end)) || (!d.ascending && !ret.After(d.end)) {
+		d.done = true
+		return option.Nothing[time.Time]()
+	}
+	d.i++
+	d.cur = d.advance(d.i, d.cur)
+	return option.Some(ret)
+}
+
+// Returns an independent copy of the range at its current position.
+func (d *dateRangeIterator) Clone() Iterator[time.Time] {
+	c := *d
+	return &c
+}
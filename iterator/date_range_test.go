@@ -0,0 +1,102 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+func TestDateRange(t *testing.T) {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	t.Run("forwards", func(t *testing.T) {
+		iter := iterator.DateRange(start, start.Add(3*time.Hour), time.Hour)
+		expected := []time.Time{start, start.Add(time.Hour), start.Add(2 * time.Hour)}
+		if !reflect.DeepEqual(iterator.Collect[time.Time](iter), expected) {
+			t.Fail()
+		}
+	})
+	t.Run("backwards", func(t *testing.T) {
+		iter := iterator.DateRange(start, start.Add(-90*time.Minute), -time.Hour)
+		expected := []time.Time{start, start.Add(-time.Hour)}
+		if !reflect.DeepEqual(iterator.Collect[time.Time](iter), expected) {
+			t.Fail()
+		}
+	})
+	t.Run("wrong direction", func(t *testing.T) {
+		if iterator.DateRange(start, start.Add(time.Hour), -time.Hour).Next().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("longer than a duration", func(t *testing.T) {
+		from := time.Date(1800, 1, 1, 0, 0, 0, 0, time.UTC)
+		iter := iterator.DateRange(from, time.Date(2200, 1, 1, 0, 0, 0, 0, time.UTC), 24*time.Hour)
+		last := iterator.Fold[time.Time](iter, from, func(prev time.Time, t time.Time) time.Time {
+			if t.Before(prev) {
+				panic("date range went backwards")
+			}
+			return t
+		})
+		if !last.Equal(time.Date(2199, 12, 31, 0, 0, 0, 0, time.UTC)) || iter.Next().IsSome() {
+			t.Fatalf("ended at %v", last)
+		}
+	})
+	t.Run("clone", func(t *testing.T) {
+		iter := iterator.DateRange(start, start.Add(3*time.Hour), time.Hour)
+		iter.Next()
+		clone := iter.Clone()
+		expected := []time.Time{start.Add(time.Hour), start.Add(2 * time.Hour)}
+		if !reflect.DeepEqual(iterator.Collect[time.Time](iter), expected) {
+			t.Fail()
+		}
+		if !reflect.DeepEqual(iterator.Collect(clone), expected) {
+			t.Fail()
+		}
+	})
+	t.Run("zero step", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Fail()
+			}
+		}()
+		iterator.DateRange(start, start.Add(time.Hour), 0)
+	})
+}
+
+func TestDateRangeCalendar(t *testing.T) {
+	t.Run("monthly does not drift", func(t *testing.T) {
+		start := time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+		iter := iterator.DateRangeCalendar(start, time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC), 0, 1, 0)
+		expected := []time.Time{
+			start,
+			time.Date(2023, 3, 3, 0, 0, 0, 0, time.UTC),
+			time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2023, 5, 31, 0, 0, 0, 0, time.UTC),
+		}
+		if !reflect.DeepEqual(iterator.Collect[time.Time](iter), expected) {
+			t.Fail()
+		}
+	})
+	t.Run("daily across DST", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			t.Skip("time zone database unavailable")
+		}
+		start := time.Date(2024, 3, 9, 12, 0, 0, 0, loc)
+		iter := iterator.DateRangeCalendar(start, time.Date(2024, 3, 12, 0, 0, 0, 0, loc), 0, 0, 1)
+		for d := iter.Next(); d.IsSome(); d = iter.Next() {
+			if d.Unwrap().Hour() != 12 {
+				t.Errorf("expected noon, got %v", d.Unwrap())
+			}
+		}
+	})
+	t.Run("backwards", func(t *testing.T) {
+		start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		iter := iterator.DateRangeCalendar(start, time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), -1, 0, 0)
+		if iterator.Count[time.Time](iter) != 3 {
+			t.Fail()
+		}
+	})
+}
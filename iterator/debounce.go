@@ -0,0 +1,60 @@
+package iterator
+
+import (
+	"time"
+
+	"github.com/sidkurella/goption/option"
+)
+
+// An iterator that only yields elements that are not followed by another within a quiet period.
+type debounceIterator[T any] struct {
+	timedSource[T]
+	d time.Duration
+}
+
+// Debounce creates an iterator that yields an element of inner only once d has elapsed without another element
+// arriving; elements superseded within that period are dropped. When inner is exhausted, the last pending element
+// is yielded immediately. If clock is nil, SystemClock is used.
+//
+// Elements are pulled from inner on a background goroutine. Defer Close, as with FromSeq, if the iterator may not
+// be consumed to exhaustion. If inner is a FallibleIterator, its error is reported by Err.
+func Debounce[T any](inner Iterator[T], d time.Duration, clock Clock) *debounceIterator[T] {
+	return &debounceIterator[T]{
+		timedSource: newTimedSource(inner, clock),
+		d:           d,
+	}
+}
+
+func (db *debounceIterator[T]) Next() option.Option[T] {
+	if db.done {
+		return option.Nothing[T]()
+	}
+	f := db.start()
+	item, open := <-f.items
+	if !open {
+		db.finish()
+		return option.Nothing[T]()
+	}
+	pending := f.unwrap(item)
+	for {
+		select {
+		case item, open := <-f.items:
+			if !open {
+				db.finish()
+				return option.Some(pending)
+			}
+			pending = f.unwrap(item)
+		case <-db.clock.After(db.d):
+			// An element that arrived just as the timer fired still supersedes the pending one.
+			val, ok, open := f.tryRecv()
+			if ok {
+				pending = val
+				continue
+			}
+			if !open {
+				db.finish()
+			}
+			return option.Some(pending)
+		}
+	}
+}
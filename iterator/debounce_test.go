@@ -0,0 +1,35 @@
+package iterator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+)
+
+func TestDebounce(t *testing.T) {
+	clock := newFakeClock(false)
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 2
+	iter := iterator.Debounce[int](iterator.FromChan(ch), time.Second, clock)
+	defer iter.Close()
+	results := make(chan option.Option[int])
+	go func() {
+		results <- iter.Next()
+	}()
+	clock.WaitForCalls(2) // One timer per element received.
+	clock.Advance(time.Second)
+	if <-results != option.Some(2) {
+		t.Fail()
+	}
+	ch <- 3
+	close(ch)
+	if iter.Next() != option.Some(3) { // Yielded without waiting, since the channel was closed.
+		t.Fail()
+	}
+	if iter.Next().IsSome() {
+		t.Fail()
+	}
+}
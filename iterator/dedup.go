@@ -0,0 +1,59 @@
+package iterator
+
+import "github.com/sidkurella/goption/option"
+
+type dedupIterator[T any] struct {
+	inner Iterator[T]
+	same  func(T, T) bool
+	last  option.Option[T] // The last element yielded.
+}
+
+// Creates an iterator that removes consecutive repeated elements.
+// Only adjacent duplicates are removed; to remove all duplicates, use Unique.
+func Dedup[T comparable](inner Iterator[T]) *dedupIterator[T] {
+	return DedupBy(inner, func(a T, b T) bool {
+		return a == b
+	})
+}
+
+// Creates an iterator that removes consecutive elements for which same returns true.
+// same is called with the last yielded element and the candidate element, in that order.
+func DedupBy[T any](inner Iterator[T], same func(T, T) bool) *dedupIterator[T] {
+	return &dedupIterator[T]{
+		inner: inner,
+		same:  same,
+		last:  option.Nothing[T](),
+	}
+}
+
+// Creates an iterator that removes consecutive elements which map to the same key.
+func DedupByKey[T any, K comparable](inner Iterator[T], key func(T) K) *dedupIterator[T] {
+	return DedupBy(inner, func(a T, b T) bool {
+		return key(a) == key(b)
+	})
+}
+
+func (d *dedupIterator[T]) Next() option.Option[T] {
+	for item := d.inner.Next(); item.IsSome(); item = d.inner.Next() {
+		last, ok := d.last.Get()
+		if !ok || !d.same(last, item.Unwrap()) {
+			d.last = item
+			return item
+		}
+	}
+	return option.Nothing[T]()
+}
+
+// Returns the bounds on the remaining length. All but one element may be removed, so the lower bound is at most 1.
+func (d *dedupIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	lower, upper := SizeHint(d.inner)
+	if d.last.IsSome() {
+		return 0, upper
+	}
+	return min(lower, 1), upper
+}
+
+// Close closes the inner iterator if it implements Closer.
+func (d *dedupIterator[T]) Close() {
+	closeIter(d.inner)
+}
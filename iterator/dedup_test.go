@@ -0,0 +1,39 @@
+package iterator_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+func TestDedup(t *testing.T) {
+	iter := iterator.Dedup[int](&fakeIterator{elements: []int{1, 1, 2, 3, 3, 3, 1}})
+	expected := []int{1, 2, 3, 1}
+	actual := iterator.Collect[int](iter)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+}
+
+func TestDedupBy(t *testing.T) {
+	iter := iterator.DedupBy[int](&fakeIterator{elements: []int{1, 2, 4, 5, 7, 8}}, func(a int, b int) bool {
+		return b-a == 1
+	})
+	expected := []int{1, 4, 7}
+	actual := iterator.Collect[int](iter)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+}
+
+func TestDedupByKey(t *testing.T) {
+	iter := iterator.DedupByKey[string](sliceutil.Iter([]string{"a", "A", "b", "B", "a"}), strings.ToLower)
+	expected := []string{"a", "b", "a"}
+	actual := iterator.Collect[string](iter)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+}
@@ -0,0 +1,157 @@
+package iterator
+
+import (
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/result"
+)
+
+// DoubleEndedIterator is an iterator that can also yield elements from the back via NextBack.
+// Next and NextBack draw from the same underlying sequence; once they meet in the middle,
+// both return Nothing.
+type DoubleEndedIterator[T any] interface {
+	Iterator[T]
+	NextBack() option.Option[T]
+}
+
+// Advances the iterator from the back by n elements.
+// This method will eagerly skip n elements by calling NextBack up to n times until Nothing is encountered.
+// Returns Ok[struct{}{}] if successful.
+// Returns Err[k] if Nothing is encountered, where k is the number of elements advanced before hitting the end.
+func AdvanceBackBy[T any](iter DoubleEndedIterator[T], n uint64) result.Result[struct{}, uint64] {
+	for i := uint64(0); i < n; i++ {
+		obj := iter.NextBack()
+		if obj.IsNothing() {
+			return result.Err[struct{}](i)
+		}
+	}
+	return result.Ok[struct{}, uint64](struct{}{})
+}
+
+// Advances the iterator from the back by n and returns the nth item from the end.
+// Count starts from 0, so NthBack(I, 0) returns the last element.
+// Returns Nothing if n is greater or equal to the length of the iterator.
+func NthBack[T any](iter DoubleEndedIterator[T], n uint64) option.Option[T] {
+	return option.AndThen(
+		AdvanceBackBy(iter, n).Ok(),
+		func(_ struct{}) option.Option[T] {
+			return iter.NextBack()
+		},
+	)
+}
+
+// RFind searches for the last element of the iterator that satisfies the predicate, starting from the back.
+// Returns Some[T] for the first element from the back that returns true. Short-circuits upon finding it.
+// If no element satisfies the predicate, returns Nothing.
+func RFind[T any](iter DoubleEndedIterator[T], pred func(T) bool) option.Option[T] {
+	for item := iter.NextBack(); item.IsSome(); item = iter.NextBack() {
+		if pred(item.Unwrap()) {
+			return item
+		}
+	}
+	return option.Nothing[T]()
+}
+
+// RFold folds every element into an accumulator starting from the back, returning the final value.
+// The entire iterator will be consumed by this.
+func RFold[T any, A any](iter DoubleEndedIterator[T], a A, f func(A, T) A) A {
+	for item := iter.NextBack(); item.IsSome(); item = iter.NextBack() {
+		a = f(a, item.Unwrap())
+	}
+	return a
+}
+
+// bufferIterator is a double-ended iterator over a buffered slice of elements.
+type bufferIterator[T any] struct {
+	data   []T
+	i      int         // Represents the next element to return from the front.
+	j      int         // Represents one past the next element to return from the back.
+	source Iterator[T] // The iterator the elements were buffered from, if any. Close and Err are forwarded to it.
+}
+
+func newBufferIterator[T any](data []T) *bufferIterator[T] {
+	return &bufferIterator[T]{
+		data: data,
+		i:    0,
+		j:    len(data),
+	}
+}
+
+func (b *bufferIterator[T]) Next() option.Option[T] {
+	if b.i >= b.j {
+		return option.Nothing[T]()
+	}
+	ret := option.Some(b.data[b.i])
+	b.i++
+	return ret
+}
+
+func (b *bufferIterator[T]) NextBack() option.Option[T] {
+	if b.i >= b.j {
+		return option.Nothing[T]()
+	}
+	b.j--
+	return option.Some(b.data[b.j])
+}
+
+// Len returns the number of elements remaining in the buffer.
+func (b *bufferIterator[T]) Len() uint64 {
+	return uint64(b.j - b.i)
+}
+
+// Returns the bounds on the remaining length, which is known exactly.
+func (b *bufferIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	return exactSizeHint(b.Len())
+}
+
+// Close closes the iterator the elements were buffered from, if it implements Closer.
+func (b *bufferIterator[T]) Close() {
+	closeIter(b.source)
+}
+
+// Err returns the error of the iterator the elements were buffered from, if any.
+func (b *bufferIterator[T]) Err() error {
+	return errOf(b.source)
+}
+
+// Returns an independent copy of the iterator at its current position, sharing the buffered elements.
+func (b *bufferIterator[T]) Clone() Iterator[T] {
+	c := *b
+	return &c
+}
+
+// bufferOf buffers the remaining elements of iter. The buffer keeps iter, so that closing the buffer still closes it.
+func bufferOf[T any](iter Iterator[T]) *bufferIterator[T] {
+	buf := newBufferIterator(Collect(iter))
+	buf.source = iter
+	return buf
+}
+
+// backOf returns the iterator held in inner as a DoubleEndedIterator.
+// If it cannot iterate from the back, its remaining elements are buffered and inner is replaced with the buffer,
+// so that later calls from either end are served consistently.
+func backOf[T any](inner *Iterator[T]) DoubleEndedIterator[T] {
+	if de, ok := (*inner).(DoubleEndedIterator[T]); ok {
+		return de
+	}
+	buf := bufferOf(*inner)
+	*inner = buf
+	return buf
+}
+
+// backBufferer is implemented by adapters that are DoubleEndedIterators over any iterator, but whose NextBack buffers
+// the remaining elements of their source when it cannot iterate from the back itself.
+type backBufferer interface {
+	buffersBack() bool
+}
+
+// mayBufferBack returns whether NextBack on iter may buffer the remaining elements of its source, or whether iter
+// cannot iterate from the back at all.
+func mayBufferBack[T any](iter Iterator[T]) bool {
+	if _, ok := iter.(DoubleEndedIterator[T]); !ok {
+		return true
+	}
+	if b, ok := iter.(backBufferer); ok {
+		return b.buffersBack()
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+func TestAdvanceBackBy(t *testing.T) {
+	iter := sliceutil.Iter([]int{1, 2, 3, 4, 5})
+	res := iterator.AdvanceBackBy[int](iter, 2)
+	if res.Unwrap() != struct{}{} {
+		t.Fail()
+	}
+	if iter.NextBack().Unwrap() != 3 {
+		t.Fail()
+	}
+	res = iterator.AdvanceBackBy[int](iter, 3)
+	if res.UnwrapErr() != uint64(2) {
+		t.Fail()
+	}
+}
+
+func TestNthBack(t *testing.T) {
+	t.Run("in range", func(t *testing.T) {
+		iter := sliceutil.Iter([]int{1, 2, 3, 4, 5})
+		if iterator.NthBack[int](iter, 1) != option.Some(4) {
+			t.Fail()
+		}
+		if iterator.NthBack[int](iter, 0) != option.Some(3) {
+			t.Fail()
+		}
+		if iter.Next() != option.Some(1) {
+			t.Fail()
+		}
+	})
+	t.Run("out of range", func(t *testing.T) {
+		iter := sliceutil.Iter([]int{1, 2, 3})
+		if iterator.NthBack[int](iter, 3).IsSome() {
+			t.Fail()
+		}
+	})
+}
+
+func TestRFind(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
+		iter := sliceutil.Iter([]int{1, 2, 3, 4, 5})
+		found := iterator.RFind[int](iter, func(i int) bool {
+			return i%2 == 0
+		})
+		if found != option.Some(4) {
+			t.Fail()
+		}
+		if iter.NextBack() != option.Some(3) { // Iterator is consumed up to the found element.
+			t.Fail()
+		}
+	})
+	t.Run("not found", func(t *testing.T) {
+		iter := sliceutil.Iter([]int{1, 3, 5})
+		found := iterator.RFind[int](iter, func(i int) bool {
+			return i%2 == 0
+		})
+		if found.IsSome() {
+			t.Fail()
+		}
+	})
+}
+
+func TestRFold(t *testing.T) {
+	iter := sliceutil.Iter([]int{1, 2, 3})
+	actual := iterator.RFold[int](iter, []int{}, func(a []int, i int) []int {
+		return append(a, i)
+	})
+	if !reflect.DeepEqual(actual, []int{3, 2, 1}) {
+		t.Fail()
+	}
+}
+
+func TestLast_DoubleEnded(t *testing.T) {
+	iter := iterator.Range(0, 1000)
+	if iterator.Last[int](iter) != option.Some(999) {
+		t.Fail()
+	}
+	if iter.Next() != option.Some(0) { // Only the back element was taken.
+		t.Fail()
+	}
+}
@@ -0,0 +1,17 @@
+package iterator
+
+// Creates an iterator that yields no elements.
+func Empty[T any]() *bufferIterator[T] {
+	return newBufferIterator[T](nil)
+}
+
+// Creates an iterator that yields t exactly once.
+func Once[T any](t T) *bufferIterator[T] {
+	return newBufferIterator([]T{t})
+}
+
+// Creates a double-ended iterator over the elements of data, which can be copied cheaply with Clone.
+// The slice is not copied, so it must not be modified while it is being iterated over.
+func FromSlice[T any](data []T) *bufferIterator[T] {
+	return newBufferIterator(data)
+}
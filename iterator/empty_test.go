@@ -0,0 +1,44 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+)
+
+func TestEmpty(t *testing.T) {
+	iter := iterator.Empty[int]()
+	if iter.Next().IsSome() || iter.NextBack().IsSome() {
+		t.Fail()
+	}
+}
+
+func TestOnce(t *testing.T) {
+	iter := iterator.Chain[int](iterator.Once(1), iterator.Once(2))
+	expected := []int{1, 2}
+	actual := iterator.Collect[int](iter)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+	if iterator.Once(5).NextBack() != option.Some(5) {
+		t.Fail()
+	}
+}
+
+func TestFromSlice(t *testing.T) {
+	data := []int{1, 2, 3}
+	iter := iterator.FromSlice(data)
+	if iter.NextBack() != option.Some(3) || iter.Len() != 2 {
+		t.Fail()
+	}
+	var cloner iterator.Cloner[int] = iter
+	clone := cloner.Clone()
+	if !reflect.DeepEqual(iterator.Collect[int](iter), []int{1, 2}) {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(iterator.Collect(clone), []int{1, 2}) {
+		t.Fail()
+	}
+}
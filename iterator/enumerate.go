@@ -35,3 +35,40 @@ func (e *enumerateIterator[T]) Next() option.Option[pair.Pair[int, T]] {
 	e.i++
 	return ret
 }
+
+// Returns the last item from the iterator, paired with its index.
+// The index of the back element depends on the number of remaining elements. Unless the wrapped iterator is both
+// a DoubleEndedIterator and an ExactSizeIterator, its remainder is buffered on the first call.
+func (e *enumerateIterator[T]) NextBack() option.Option[pair.Pair[int, T]] {
+	back, ok := e.inner.(exactDoubleEndedIterator[T])
+	if !ok {
+		buf := bufferOf(e.inner)
+		e.inner = buf
+		back = buf
+	}
+	val, ok := back.NextBack().Get()
+	if !ok {
+		return option.Nothing[pair.Pair[int, T]]()
+	}
+	return option.Some(
+		pair.Pair[int, T]{
+			First:  e.i + int(back.Len()),
+			Second: val,
+		},
+	)
+}
+
+func (e *enumerateIterator[T]) buffersBack() bool {
+	_, ok := e.inner.(exactDoubleEndedIterator[T])
+	return !ok || mayBufferBack(e.inner)
+}
+
+// Returns the bounds on the remaining length, which are those of the wrapped iterator.
+func (e *enumerateIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	return SizeHint(e.inner)
+}
+
+// Close closes the inner iterator if it implements Closer.
+func (e *enumerateIterator[T]) Close() {
+	closeIter(e.inner)
+}
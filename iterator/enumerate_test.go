@@ -44,3 +44,25 @@ func TestEnumerate_Empty(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestEnumerate_NextBack(t *testing.T) {
+	i1 := &fakeStringIterator{
+		elements: []string{"one", "two", "three", "four"},
+	}
+	iter := iterator.Enumerate[string](i1)
+	if iter.Next().Unwrap() != (pair.Pair[int, string]{First: 0, Second: "one"}) {
+		t.Fail()
+	}
+	if iter.NextBack().Unwrap() != (pair.Pair[int, string]{First: 3, Second: "four"}) {
+		t.Fail()
+	}
+	if iter.NextBack().Unwrap() != (pair.Pair[int, string]{First: 2, Second: "three"}) {
+		t.Fail()
+	}
+	if iter.Next().Unwrap() != (pair.Pair[int, string]{First: 1, Second: "two"}) {
+		t.Fail()
+	}
+	if iter.NextBack().IsSome() {
+		t.Fail()
+	}
+}
@@ -0,0 +1,68 @@
+package iterator
+
+import (
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/result"
+)
+
+// FallibleIterator is an iterator that may stop early because of an error, such as one backed by I/O.
+// As with bufio.Scanner, Next returns Nothing both when the iterator is exhausted and when it fails;
+// Err distinguishes the two by returning the error that stopped the iterator, or nil if it ran to completion.
+// Err should be checked once Next has returned Nothing.
+type FallibleIterator[T any] interface {
+	Iterator[T]
+	Err() error
+}
+
+// errOf returns the error reported by iter if it implements FallibleIterator's Err method, or nil otherwise.
+func errOf(iter any) error {
+	if f, ok := iter.(interface{ Err() error }); ok {
+		return f.Err()
+	}
+	return nil
+}
+
+// An iterator that yields the elements of a FallibleIterator as results, followed by its error, if any.
+type resultsIterator[T any] struct {
+	inner FallibleIterator[T]
+	done  bool
+}
+
+// Results creates an iterator that yields each element of iter as an Ok result.
+// If iter stops because of an error, that error is yielded once as an Err result before the iterator ends.
+// Combined with TryCollect, this collects a FallibleIterator into a Result.
+func Results[T any](iter FallibleIterator[T]) *resultsIterator[T] {
+	return &resultsIterator[T]{
+		inner: iter,
+		done:  false,
+	}
+}
+
+func (r *resultsIterator[T]) Next() option.Option[result.Result[T, error]] {
+	if r.done {
+		return option.Nothing[result.Result[T, error]]()
+	}
+	if val, ok := r.inner.Next().Get(); ok {
+		return option.Some(result.Ok[T, error](val))
+	}
+	r.done = true
+	if err := r.inner.Err(); err != nil {
+		return option.Some(result.Err[T, error](err))
+	}
+	return option.Nothing[result.Result[T, error]]()
+}
+
+// Returns the bounds on the remaining length. The inner iterator's error may add one more element.
+func (r *resultsIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	if r.done {
+		return exactSizeHint(0)
+	}
+	lower, upper := SizeHint[T](r.inner)
+	return lower, addUpper(upper, option.Some[uint64](1))
+}
+
+// Close closes the inner iterator if it implements Closer.
+func (r *resultsIterator[T]) Close() {
+	closeIter(r.inner)
+	r.done = true
+}
@@ -0,0 +1,59 @@
+package iterator_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/result"
+)
+
+var errFake = errors.New("fake error")
+
+// fakeFallibleIterator yields its elements and then fails with err, if it is not nil.
+type fakeFallibleIterator struct {
+	fakeIterator
+	err    error
+	failed bool
+}
+
+func (f *fakeFallibleIterator) Next() option.Option[int] {
+	ret := f.fakeIterator.Next()
+	if ret.IsNothing() && f.err != nil {
+		f.failed = true
+	}
+	return ret
+}
+
+func (f *fakeFallibleIterator) Err() error {
+	if f.failed {
+		return f.err
+	}
+	return nil
+}
+
+var _ iterator.FallibleIterator[int] = &fakeFallibleIterator{}
+
+func TestResults(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		iter := &fakeFallibleIterator{fakeIterator: fakeIterator{elements: []int{1, 2, 3}}}
+		actual := iterator.TryCollect[int, error](iterator.Results[int](iter))
+		if !reflect.DeepEqual(actual, result.Ok[[]int, error]([]int{1, 2, 3})) {
+			t.Fail()
+		}
+	})
+	t.Run("failure", func(t *testing.T) {
+		iter := &fakeFallibleIterator{fakeIterator: fakeIterator{elements: []int{1, 2}}, err: errFake}
+		expected := []result.Result[int, error]{
+			result.Ok[int, error](1),
+			result.Ok[int, error](2),
+			result.Err[int, error](errFake),
+		}
+		actual := iterator.Collect[result.Result[int, error]](iterator.Results[int](iter))
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fail()
+		}
+	})
+}
@@ -20,3 +20,24 @@ func Filter[T any](iter Iterator[T], pred func(T) bool) *filterIterator[T] {
 func (f *filterIterator[T]) Next() option.Option[T] {
 	return Find(f.inner, f.pred)
 }
+
+// Returns the last element from the inner iterator that passes the filter predicate.
+// If the inner iterator is not a DoubleEndedIterator, its remaining elements are buffered first.
+func (f *filterIterator[T]) NextBack() option.Option[T] {
+	return RFind(backOf(&f.inner), f.pred)
+}
+
+func (f *filterIterator[T]) buffersBack() bool {
+	return mayBufferBack(f.inner)
+}
+
+// Returns the bounds on the remaining length. Any element may be filtered out, so the lower bound is 0.
+func (f *filterIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	_, upper := SizeHint(f.inner)
+	return 0, upper
+}
+
+// Close closes the inner iterator if it implements Closer.
+func (f *filterIterator[T]) Close() {
+	closeIter(f.inner)
+}
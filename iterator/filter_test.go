@@ -20,3 +20,14 @@ func TestFilter(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestFilter_NextBack(t *testing.T) {
+	expected := []int{6, 4, 2}
+	iter := iterator.Filter[int](iterator.RangeInclusive(1, 6), func(t int) bool {
+		return t%2 == 0
+	})
+	calls := iterator.Collect[int](iterator.Rev[int](iter))
+	if !reflect.DeepEqual(calls, expected) {
+		t.Fail()
+	}
+}
@@ -26,3 +26,8 @@ func (f *filterMapIterator[T, U]) Next() option.Option[U] {
 	}
 	return option.Nothing[U]()
 }
+
+// Close closes the inner iterator if it implements Closer.
+func (f *filterMapIterator[T, U]) Close() {
+	closeIter(f.inner)
+}
@@ -0,0 +1,90 @@
+package iterator
+
+import (
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/result"
+)
+
+// An iterator that yields every element of each iterator produced by the outer iterator, in order.
+type flattenIterator[T any] struct {
+	outer Iterator[Iterator[T]]
+	front option.Option[Iterator[T]] // The inner iterator currently being drained.
+}
+
+// Creates an iterator that flattens an iterator of iterators, yielding every element of each inner iterator
+// in turn. Inner iterators are pulled from outer lazily, only once the previous one is exhausted.
+func Flatten[T any](outer Iterator[Iterator[T]]) *flattenIterator[T] {
+	return &flattenIterator[T]{
+		outer: outer,
+		front: option.Nothing[Iterator[T]](),
+	}
+}
+
+// Creates an iterator that maps each element of iter to an iterator with f, and yields the elements of each
+// resulting iterator in turn.
+// This is equivalent to Flatten(Map(iter, f)), but does not require materializing the mapped elements.
+func FlatMap[T any, U any](iter Iterator[T], f func(T) Iterator[U]) *flattenIterator[U] {
+	return Flatten[U](Map(iter, f))
+}
+
+// Creates an iterator that yields every element of each slice produced by iter, in order.
+func FlattenSlices[T any](iter Iterator[[]T]) *flattenIterator[T] {
+	return FlatMap(iter, func(s []T) Iterator[T] {
+		return newBufferIterator(s)
+	})
+}
+
+// Creates an iterator that yields the value of each Some element of iter, skipping Nothing elements.
+func FlattenOptions[T any](iter Iterator[option.Option[T]]) *filterMapIterator[option.Option[T], T] {
+	return FilterMap(iter, func(o option.Option[T]) option.Option[T] {
+		return o
+	})
+}
+
+// Creates an iterator that yields the value of each Ok element of iter, skipping Err elements.
+// If errors must not be discarded, use TryCollect or TryFold instead.
+func FlattenResults[T any, E any](iter Iterator[result.Result[T, E]]) *filterMapIterator[result.Result[T, E], T] {
+	return FilterMap(iter, func(r result.Result[T, E]) option.Option[T] {
+		return r.Ok()
+	})
+}
+
+func (f *flattenIterator[T]) Next() option.Option[T] {
+	for {
+		if front, ok := f.front.Get(); ok {
+			if val := front.Next(); val.IsSome() {
+				return val
+			}
+			f.front = option.Nothing[Iterator[T]]()
+		}
+		next := f.outer.Next()
+		if next.IsNothing() {
+			return option.Nothing[T]()
+		}
+		f.front = next
+	}
+}
+
+// Returns the bounds on the remaining length.
+// The lower bound covers the inner iterator currently being drained. The upper bound is only known once the
+// outer iterator is known to be empty.
+func (f *flattenIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	lower, upper := uint64(0), option.Some(uint64(0))
+	if front, ok := f.front.Get(); ok {
+		lower, upper = SizeHint(front)
+	}
+	if _, outerUpper := SizeHint(f.outer); outerUpper != option.Some(uint64(0)) {
+		upper = option.Nothing[uint64]()
+	}
+	return lower, upper
+}
+
+// Close closes the inner iterator currently being drained and the outer iterator, for those that implement Closer.
+// Inner iterators that have not yet been pulled from the outer iterator are not closed.
+func (f *flattenIterator[T]) Close() {
+	if front, ok := f.front.Get(); ok {
+		closeIter(front)
+		f.front = option.Nothing[Iterator[T]]()
+	}
+	closeIter(f.outer)
+}
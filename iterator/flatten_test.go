@@ -0,0 +1,79 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/result"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+func TestFlatten(t *testing.T) {
+	outer := sliceutil.Iter([]iterator.Iterator[int]{
+		iterator.Range(0, 2),
+		&fakeIterator{},
+		sliceutil.Iter([]int{10, 20}),
+	})
+	expected := []int{0, 1, 10, 20}
+	actual := iterator.Collect[int](iterator.Flatten[int](outer))
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	t.Run("flat map", func(t *testing.T) {
+		iter := iterator.FlatMap[int](&fakeIterator{elements: []int{1, 2, 3}}, func(i int) iterator.Iterator[int] {
+			return iterator.Take[int](iterator.RangeBy(i, 100, i), uint64(i))
+		})
+		expected := []int{1, 2, 4, 3, 6, 9}
+		actual := iterator.Collect[int](iter)
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fail()
+		}
+	})
+	t.Run("lazy", func(t *testing.T) {
+		calls := 0
+		iter := iterator.FlatMap[int](iterator.Range(0, 100), func(i int) iterator.Iterator[int] {
+			calls++
+			return iterator.Range(0, i)
+		})
+		iterator.Collect[int](iterator.Take[int](iter, 1))
+		if calls != 2 { // The first inner iterator is empty.
+			t.Fail()
+		}
+	})
+}
+
+func TestFlattenSlices(t *testing.T) {
+	iter := iterator.FlattenSlices[string](sliceutil.Iter([][]string{{"a", "b"}, {}, {"c"}}))
+	expected := []string{"a", "b", "c"}
+	actual := iterator.Collect[string](iter)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+}
+
+func TestFlattenOptions(t *testing.T) {
+	iter := iterator.FlattenOptions[int](sliceutil.Iter([]option.Option[int]{
+		option.Some(1), option.Nothing[int](), option.Some(3),
+	}))
+	expected := []int{1, 3}
+	actual := iterator.Collect[int](iter)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+}
+
+func TestFlattenResults(t *testing.T) {
+	iter := iterator.FlattenResults[int, string](sliceutil.Iter([]result.Result[int, string]{
+		result.Ok[int, string](1), result.Err[int]("bad"), result.Ok[int, string](3),
+	}))
+	expected := []int{1, 3}
+	actual := iterator.Collect[int](iter)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+}
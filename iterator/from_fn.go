@@ -0,0 +1,39 @@
+package iterator
+
+import "github.com/sidkurella/goption/option"
+
+type fromFnIterator[T any] struct {
+	f func() option.Option[T]
+}
+
+// Creates an iterator that yields the result of calling f on each call to Next.
+// The iterator ends when f returns Nothing, though f may be called again afterwards.
+func FromFn[T any](f func() option.Option[T]) *fromFnIterator[T] {
+	return &fromFnIterator[T]{
+		f: f,
+	}
+}
+
+func (f *fromFnIterator[T]) Next() option.Option[T] {
+	return f.f()
+}
+
+type successorsIterator[T any] struct {
+	next option.Option[T]
+	succ func(T) option.Option[T]
+}
+
+// Creates an iterator that starts with first and computes each following element from the previous one with succ.
+// The iterator ends once succ returns Nothing, or immediately if first is Nothing.
+func Successors[T any](first option.Option[T], succ func(T) option.Option[T]) *successorsIterator[T] {
+	return &successorsIterator[T]{
+		next: first,
+		succ: succ,
+	}
+}
+
+func (s *successorsIterator[T]) Next() option.Option[T] {
+	ret := s.next
+	s.next = option.AndThen(ret, s.succ)
+	return ret
+}
@@ -0,0 +1,59 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+)
+
+func TestFromFn(t *testing.T) {
+	count := 0
+	iter := iterator.FromFn(func() option.Option[int] {
+		count++
+		if count > 3 {
+			return option.Nothing[int]()
+		}
+		return option.Some(count)
+	})
+	expected := []int{1, 2, 3}
+	actual := iterator.Collect[int](iter)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+}
+
+func TestSuccessors(t *testing.T) {
+	t.Run("successors", func(t *testing.T) {
+		iter := iterator.Successors(option.Some(1), func(i int) option.Option[int] {
+			if i >= 1000 {
+				return option.Nothing[int]()
+			}
+			return option.Some(i * 10)
+		})
+		expected := []int{1, 10, 100, 1000}
+		actual := iterator.Collect[int](iter)
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fail()
+		}
+	})
+	t.Run("infinite", func(t *testing.T) {
+		iter := iterator.Successors(option.Some(1), func(i int) option.Option[int] {
+			return option.Some(i * 2)
+		})
+		expected := []int{1, 2, 4, 8}
+		actual := iterator.Collect[int](iterator.Take[int](iter, 4))
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fail()
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		iter := iterator.Successors(option.Nothing[int](), func(i int) option.Option[int] {
+			return option.Some(i)
+		})
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+}
@@ -23,3 +23,8 @@ func (f *fuseIterator[T]) Next() option.Option[T] {
 	f.returnNothing = ret.IsNothing()
 	return ret
 }
+
+// Close closes the inner iterator if it implements Closer.
+func (f *fuseIterator[T]) Close() {
+	closeIter(f.inner)
+}
@@ -0,0 +1,67 @@
+package iterator
+
+import (
+	"github.com/sidkurella/goption/maputil"
+	"github.com/sidkurella/goption/result"
+)
+
+// CollectMap collects the iterator into a map, using f to turn each element into a key-value pair.
+// If two elements map to the same key, merge is called with the existing value and the new value, and its result
+// is stored under that key.
+func CollectMap[T any, K comparable, V any](iter Iterator[T], f func(T) (K, V), merge func(V, V) V) maputil.Map[K, V] {
+	return Fold(iter, maputil.New[K, V](), func(m maputil.Map[K, V], t T) maputil.Map[K, V] {
+		k, v := f(t)
+		if existing, ok := m.Get(k).Get(); ok {
+			v = merge(existing, v)
+		}
+		m.Insert(k, v)
+		return m
+	})
+}
+
+// GroupBy collects the iterator into a map from each key returned by keyFn to the elements with that key.
+// Elements in each group keep their order from the iterator.
+func GroupBy[T any, K comparable](iter Iterator[T], keyFn func(T) K) maputil.Map[K, []T] {
+	return CollectMap(iter,
+		func(t T) (K, []T) {
+			return keyFn(t), []T{t}
+		},
+		func(group []T, t []T) []T {
+			return append(group, t...)
+		},
+	)
+}
+
+// CountBy counts the elements of the iterator by the key returned by keyFn.
+func CountBy[T any, K comparable](iter Iterator[T], keyFn func(T) K) maputil.Map[K, uint64] {
+	return CollectMap(iter,
+		func(t T) (K, uint64) {
+			return keyFn(t), 1
+		},
+		func(a uint64, b uint64) uint64 {
+			return a + b
+		},
+	)
+}
+
+// Frequencies counts the number of times each distinct element occurs in the iterator.
+func Frequencies[T comparable](iter Iterator[T]) maputil.Map[T, uint64] {
+	return CountBy(iter, func(t T) T {
+		return t
+	})
+}
+
+// IndexBy collects the iterator into a map from the key returned by keyFn to the element with that key.
+// Keys are expected to be unique. It short-circuits upon reaching the first element whose key is already in the
+// map, returning Err[OccupiedError] holding that key and the element already stored under it.
+func IndexBy[T any, K comparable](
+	iter Iterator[T], keyFn func(T) K,
+) result.Result[maputil.Map[K, T], maputil.OccupiedError[K, T]] {
+	return TryFold(iter, maputil.New[K, T](),
+		func(m maputil.Map[K, T], t T) result.Result[maputil.Map[K, T], maputil.OccupiedError[K, T]] {
+			return result.Map(m.TryInsert(keyFn(t), t), func(_ T) maputil.Map[K, T] {
+				return m
+			})
+		},
+	)
+}
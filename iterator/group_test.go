@@ -0,0 +1,80 @@
+package iterator_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/maputil"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+func TestCollectMap(t *testing.T) {
+	words := sliceutil.Iter([]string{"apple", "avocado", "banana"})
+	actual := iterator.CollectMap(words,
+		func(s string) (byte, int) {
+			return s[0], len(s)
+		},
+		func(a int, b int) int {
+			return max(a, b)
+		},
+	)
+	expected := map[byte]int{'a': 7, 'b': 6}
+	if !reflect.DeepEqual(actual.Into(), expected) {
+		t.Fail()
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	actual := iterator.GroupBy[int](iterator.Range(0, 7), func(i int) int {
+		return i % 3
+	})
+	expected := map[int][]int{0: {0, 3, 6}, 1: {1, 4}, 2: {2, 5}}
+	if !reflect.DeepEqual(actual.Into(), expected) {
+		t.Fail()
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	actual := iterator.CountBy[string](sliceutil.Iter([]string{"a", "bb", "cc", "ddd"}), func(s string) int {
+		return len(s)
+	})
+	expected := map[int]uint64{1: 1, 2: 2, 3: 1}
+	if !reflect.DeepEqual(actual.Into(), expected) {
+		t.Fail()
+	}
+}
+
+func TestFrequencies(t *testing.T) {
+	actual := iterator.Frequencies[string](sliceutil.Iter(strings.Split("a b a c a b", " ")))
+	expected := map[string]uint64{"a": 3, "b": 2, "c": 1}
+	if !reflect.DeepEqual(actual.Into(), expected) {
+		t.Fail()
+	}
+}
+
+func TestIndexBy(t *testing.T) {
+	t.Run("unique", func(t *testing.T) {
+		actual := iterator.IndexBy[string](sliceutil.Iter([]string{"a", "bb"}), func(s string) int {
+			return len(s)
+		})
+		expected := map[int]string{1: "a", 2: "bb"}
+		if !reflect.DeepEqual(actual.Unwrap().Into(), expected) {
+			t.Fail()
+		}
+	})
+	t.Run("duplicate", func(t *testing.T) {
+		iter := sliceutil.Iter([]string{"a", "bb", "c", "d"})
+		actual := iterator.IndexBy[string](iter, func(s string) int {
+			return len(s)
+		})
+		expected := maputil.OccupiedError[int, string]{Key: 1, Value: "a"}
+		if actual.UnwrapErr() != expected {
+			t.Fail()
+		}
+		if iter.Next().Unwrap() != "d" { // Short-circuits after the duplicate.
+			t.Fail()
+		}
+	})
+}
@@ -23,3 +23,8 @@ func (i *inspectIterator[T]) Next() option.Option[T] {
 	}
 	return ret
 }
+
+// Close closes the inner iterator if it implements Closer.
+func (i *inspectIterator[T]) Close() {
+	closeIter(i.inner)
+}
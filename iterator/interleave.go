@@ -0,0 +1,97 @@
+package iterator
+
+import "github.com/sidkurella/goption/option"
+
+// An iterator that takes one element from each of its iterators in turn.
+type interleaveIterator[T any] struct {
+	iters    []Iterator[T] // The iterators that are not yet exhausted.
+	all      []Iterator[T] // Every iterator, including exhausted ones, so that Close reaches all of them.
+	next     int           // The index of the iterator to take the next element from.
+	shortest bool          // Whether to stop at the first exhausted iterator, rather than skip it.
+	done     bool
+}
+
+// Interleave creates an iterator that takes one element from each of iters in turn, stopping as soon as any of
+// them is exhausted. Elements already taken in the final round are still yielded, so
+// Interleave([1 2 3], [4 5]) yields 1, 4, 2, 5, 3.
+// Use RoundRobin to continue with the remaining iterators instead.
+func Interleave[T any](iters ...Iterator[T]) *interleaveIterator[T] {
+	return newInterleaveIterator(iters, true)
+}
+
+// RoundRobin creates an iterator that takes one element from each of iters in turn, skipping those that are
+// exhausted, until all of them are. RoundRobin([1 2 3], [4], [5 6]) yields 1, 4, 5, 2, 6, 3.
+func RoundRobin[T any](iters ...Iterator[T]) *interleaveIterator[T] {
+	return newInterleaveIterator(iters, false)
+}
+
+func newInterleaveIterator[T any](iters []Iterator[T], shortest bool) *interleaveIterator[T] {
+	return &interleaveIterator[T]{
+		iters:    append([]Iterator[T](nil), iters...), // Copied, since exhausted iterators are removed from it.
+		all:      append([]Iterator[T](nil), iters...),
+		next:     0,
+		shortest: shortest,
+		done:     len(iters) == 0,
+	}
+}
+
+func (it *interleaveIterator[T]) Next() option.Option[T] {
+	for !it.done {
+		val := it.iters[it.next].Next()
+		if val.IsSome() {
+			it.next = (it.next + 1) % len(it.iters)
+			return val
+		}
+		if it.shortest {
+			it.done = true
+			break
+		}
+		it.iters = append(it.iters[:it.next], it.iters[it.next+1:]...)
+		if len(it.iters) == 0 {
+			it.done = true
+			break
+		}
+		it.next %= len(it.iters)
+	}
+	return option.Nothing[T]()
+}
+
+// Returns the bounds on the remaining length.
+// For RoundRobin, this is the sum of the iterators' bounds. For Interleave, it is determined by whichever
+// iterator would be exhausted first, accounting for its place in the current round.
+func (it *interleaveIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	if it.done {
+		return exactSizeHint(0)
+	}
+	if !it.shortest {
+		lower, upper := uint64(0), option.Some[uint64](0)
+		for _, iter := range it.iters {
+			l, u := SizeHint(iter)
+			lower, upper = saturatingAdd(lower, l), addUpper(upper, u)
+		}
+		return lower, upper
+	}
+	// If the iterator d places after next has exactly k elements left, n*k+d elements are yielded before it fails.
+	n := uint64(len(it.iters))
+	lower, upper := uint64(0), option.Nothing[uint64]()
+	for i := range it.iters {
+		d := uint64((i - it.next + len(it.iters)) % len(it.iters))
+		l, u := SizeHint(it.iters[i])
+		candidate := saturatingAdd(saturatingMul(n, l), d)
+		if i == 0 || candidate < lower {
+			lower = candidate
+		}
+		upper = minUpper(upper, addUpper(mulUpper(option.Some(n), u), option.Some(d)))
+	}
+	return lower, upper
+}
+
+// Close closes all the interleaved iterators, for those that implement Closer.
+func (it *interleaveIterator[T]) Close() {
+	for _, iter := range it.all {
+		closeIter(iter)
+	}
+	it.iters = nil
+	it.all = nil
+	it.done = true
+}
@@ -0,0 +1,63 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+func TestInterleave(t *testing.T) {
+	t.Run("stops at shortest", func(t *testing.T) {
+		iter := iterator.Interleave[int](
+			sliceutil.Iter([]int{1, 2, 3}),
+			sliceutil.Iter([]int{4, 5}),
+			sliceutil.Iter([]int{6, 7, 8}),
+		)
+		lower, upper := iter.SizeHint()
+		if lower != 7 || upper != option.Some[uint64](7) {
+			t.Errorf("size hint: %v, %v", lower, upper)
+		}
+		if !reflect.DeepEqual(iterator.Collect[int](iter), []int{1, 4, 6, 2, 5, 7, 3}) {
+			t.Fail()
+		}
+	})
+	t.Run("none", func(t *testing.T) {
+		if iterator.Interleave[int]().Next().IsSome() {
+			t.Fail()
+		}
+	})
+}
+
+func TestRoundRobin(t *testing.T) {
+	iter := iterator.RoundRobin[int](
+		sliceutil.Iter([]int{1, 2, 3}),
+		sliceutil.Iter([]int{4}),
+		sliceutil.Iter([]int{5, 6}),
+	)
+	lower, upper := iter.SizeHint()
+	if lower != 6 || upper != option.Some[uint64](6) {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(iterator.Collect[int](iter), []int{1, 4, 5, 2, 6, 3}) {
+		t.Fail()
+	}
+	if iter.Next().IsSome() {
+		t.Fail()
+	}
+}
+
+func TestRoundRobin_CloseExhausted(t *testing.T) {
+	short := &fakeCloseableIterator{fakeIterator: fakeIterator{elements: []int{1}}}
+	long := &fakeCloseableIterator{fakeIterator: fakeIterator{elements: []int{2, 3, 4}}}
+	iter := iterator.RoundRobin[int](short, long)
+	iter.Next()
+	iter.Next()
+	iter.Next() // Removes short, which is exhausted.
+	iter.Close()
+	if !short.closed || !long.closed {
+		t.Fail()
+	}
+}
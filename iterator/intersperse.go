@@ -30,3 +30,8 @@ func (i *intersperseIterator[T]) Next() option.Option[T] {
 	i.nextIsItem = !i.nextIsItem
 	return ret
 }
+
+// Close closes the inner iterator if it implements Closer.
+func (i *intersperseIterator[T]) Close() {
+	closeIter(i.inner)
+}
@@ -29,3 +29,8 @@ func (i *intersperseWithIterator[T]) Next() option.Option[T] {
 	i.nextIsF = !i.nextIsF
 	return ret
 }
+
+// Close closes the inner iterator if it implements Closer.
+func (i *intersperseWithIterator[T]) Close() {
+	closeIter(i.inner)
+}
@@ -0,0 +1,157 @@
+package iterator
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sidkurella/goption/option"
+)
+
+// An iterator that reads its elements from an io.Reader.
+type readerIterator[T any] struct {
+	r      io.Reader
+	read   func() (T, error) // Returns io.EOF once there are no more elements.
+	done   bool
+	closed bool
+	err    error
+}
+
+func newReaderIterator[T any](r io.Reader, read func() (T, error)) *readerIterator[T] {
+	return &readerIterator[T]{
+		r:      r,
+		read:   read,
+		done:   false,
+		closed: false,
+		err:    nil,
+	}
+}
+
+// Lines creates an iterator over the lines of r, with line endings stripped, as read by a bufio.Scanner.
+// See Split for details on errors and cleanup.
+func Lines(r io.Reader) *readerIterator[string] {
+	return Split(r, bufio.ScanLines)
+}
+
+// Split creates an iterator over the tokens of r, as split by a bufio.Scanner using split.
+//
+// If reading fails, the iterator stops and Err reports the error.
+// Closing the iterator closes r, if it implements io.Closer, so that closing a pipeline built on the iterator
+// releases a file passed as r. Reaching the end of r or failing does not close it. To keep a reader such as
+// os.Stdin or a response body open while still closing the pipeline, pass io.NopCloser(r) instead.
+func Split(r io.Reader, split bufio.SplitFunc) *readerIterator[string] {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(split)
+	return newReaderIterator(r, func() (string, error) {
+		if scanner.Scan() {
+			return scanner.Text(), nil
+		}
+		if err := scanner.Err(); err != nil {
+			return "", err
+		}
+		return "", io.EOF
+	})
+}
+
+// CSVRecords creates an iterator over the records of the CSV data in r, read with the default settings of
+// csv.NewReader. See Split for details on errors and cleanup.
+func CSVRecords(r io.Reader) *readerIterator[[]string] {
+	return CSVRecordsFrom(csv.NewReader(r), r)
+}
+
+// CSVRecordsFrom creates an iterator over the records read by cr, which may be configured as needed.
+// src is the reader that cr reads from; it is closed when the iterator is closed if it implements io.Closer, and
+// may be nil.
+// See Split for details on errors and cleanup.
+func CSVRecordsFrom(cr *csv.Reader, src io.Reader) *readerIterator[[]string] {
+	return newReaderIterator(src, cr.Read)
+}
+
+// JSONArray creates an iterator that decodes each element of the top-level JSON array in r into a T.
+// Elements are decoded one at a time, so the array need not fit in memory.
+// If r does not hold a JSON array, or an element cannot be decoded into T, the iterator stops and Err reports the
+// error. See Split for details on cleanup.
+func JSONArray[T any](r io.Reader) *readerIterator[T] {
+	dec := json.NewDecoder(r)
+	started := false
+	return newReaderIterator(r, func() (T, error) {
+		var val T
+		if !started {
+			tok, err := dec.Token()
+			if err != nil {
+				return val, unexpectedEOF(err)
+			}
+			if tok != json.Delim('[') {
+				return val, fmt.Errorf("iterator: expected JSON array, got %v", tok)
+			}
+			started = true
+		}
+		if !dec.More() {
+			if _, err := dec.Token(); err != nil {
+				return val, unexpectedEOF(err)
+			}
+			return val, io.EOF
+		}
+		err := dec.Decode(&val)
+		return val, unexpectedEOF(err)
+	})
+}
+
+// NDJSON creates an iterator that decodes each value of the stream of JSON values in r into a T, as in
+// newline-delimited JSON. Any whitespace may separate the values. If a value cannot be decoded into T, the
+// iterator stops and Err reports the error. See Split for details on cleanup.
+func NDJSON[T any](r io.Reader) *readerIterator[T] {
+	dec := json.NewDecoder(r)
+	return newReaderIterator(r, func() (T, error) {
+		var val T
+		err := dec.Decode(&val)
+		return val, err
+	})
+}
+
+// unexpectedEOF converts io.EOF to io.ErrUnexpectedEOF, for reads that must not reach the end of the input.
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func (r *readerIterator[T]) Next() option.Option[T] {
+	if r.done {
+		return option.Nothing[T]()
+	}
+	val, err := r.read()
+	if err != nil {
+		if !errors.Is(err, io.EOF) {
+			r.err = err
+		}
+		r.done = true
+		return option.Nothing[T]()
+	}
+	return option.Some(val)
+}
+
+// Err returns the error that stopped the iterator, if reading or decoding failed, or if closing the reader failed.
+// Returns nil if the iterator ran to the end of its input or has not stopped.
+func (r *readerIterator[T]) Err() error {
+	return r.err
+}
+
+// Close closes the underlying reader if it implements io.Closer. After Close is called, Next will return Nothing.
+// It is safe to call Close multiple times or after the iterator is exhausted.
+func (r *readerIterator[T]) Close() {
+	r.done = true
+	if r.closed {
+		return
+	}
+	r.closed = true
+	if c, ok := r.r.(io.Closer); ok {
+		if err := c.Close(); err != nil && r.err == nil {
+			r.err = err
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package iterator_test
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+// fakeReadCloser records whether it was closed.
+type fakeReadCloser struct {
+	io.Reader
+	closed bool
+}
+
+func (f *fakeReadCloser) Close() error {
+	f.closed = true
+	return nil
+}
+
+func TestLines(t *testing.T) {
+	t.Run("reads lines", func(t *testing.T) {
+		r := &fakeReadCloser{Reader: strings.NewReader("a\nb\r\n\nc")}
+		iter := iterator.Lines(r)
+		actual := iterator.Collect[string](iter)
+		if !reflect.DeepEqual(actual, []string{"a", "b", "", "c"}) {
+			t.Fail()
+		}
+		if iter.Err() != nil {
+			t.Fail()
+		}
+		if r.closed {
+			t.Error("expected reader to stay open on exhaustion")
+		}
+		iter.Close()
+		if !r.closed {
+			t.Error("expected reader to be closed by Close")
+		}
+	})
+	t.Run("read error", func(t *testing.T) {
+		iter := iterator.Lines(io.MultiReader(strings.NewReader("a\n"), iotest.ErrReader(errFake)))
+		actual := iterator.Collect[string](iter)
+		if !reflect.DeepEqual(actual, []string{"a"}) {
+			t.Fail()
+		}
+		if !errors.Is(iter.Err(), errFake) {
+			t.Fail()
+		}
+	})
+	t.Run("close early", func(t *testing.T) {
+		r := &fakeReadCloser{Reader: strings.NewReader("a\nb\n")}
+		iter := iterator.Lines(r)
+		iter.Next()
+		iter.Close()
+		if !r.closed {
+			t.Fail()
+		}
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("caller keeps ownership", func(t *testing.T) {
+		r := &fakeReadCloser{Reader: strings.NewReader("a\n")}
+		iterator.CollectAndClose[string](iterator.Lines(io.NopCloser(r)))
+		if r.closed {
+			t.Fail()
+		}
+	})
+}
+
+func TestSplit(t *testing.T) {
+	iter := iterator.Split(strings.NewReader("one two  three"), bufio.ScanWords)
+	actual := iterator.Collect[string](iter)
+	if !reflect.DeepEqual(actual, []string{"one", "two", "three"}) {
+		t.Fail()
+	}
+}
+
+func TestCSVRecords(t *testing.T) {
+	t.Run("reads records", func(t *testing.T) {
+		iter := iterator.CSVRecords(strings.NewReader("a,b\n\"c,d\",e\n"))
+		actual := iterator.Collect[[]string](iter)
+		if !reflect.DeepEqual(actual, [][]string{{"a", "b"}, {"c,d", "e"}}) {
+			t.Fail()
+		}
+		if iter.Err() != nil {
+			t.Fail()
+		}
+	})
+	t.Run("parse error", func(t *testing.T) {
+		iter := iterator.CSVRecords(strings.NewReader("a,b\nc\n"))
+		actual := iterator.Collect[[]string](iter)
+		if !reflect.DeepEqual(actual, [][]string{{"a", "b"}}) {
+			t.Fail()
+		}
+		if !errors.Is(iter.Err(), csv.ErrFieldCount) {
+			t.Fail()
+		}
+	})
+	t.Run("configured reader", func(t *testing.T) {
+		src := &fakeReadCloser{Reader: strings.NewReader("a;b\n")}
+		cr := csv.NewReader(src)
+		cr.Comma = ';'
+		iter := iterator.CSVRecordsFrom(cr, src)
+		actual := iterator.CollectAndClose[[]string](iter)
+		if !reflect.DeepEqual(actual, [][]string{{"a", "b"}}) {
+			t.Fail()
+		}
+		if !src.closed {
+			t.Fail()
+		}
+	})
+}
+
+type jsonPoint struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+func TestJSONArray(t *testing.T) {
+	t.Run("decodes elements", func(t *testing.T) {
+		iter := iterator.JSONArray[jsonPoint](strings.NewReader(`[{"x":1,"y":2}, {"x":3,"y":4}]`))
+		actual := iterator.Collect[jsonPoint](iter)
+		if !reflect.DeepEqual(actual, []jsonPoint{{1, 2}, {3, 4}}) {
+			t.Fail()
+		}
+		if iter.Err() != nil {
+			t.Fail()
+		}
+	})
+	t.Run("empty array", func(t *testing.T) {
+		iter := iterator.JSONArray[int](strings.NewReader(`[]`))
+		if iter.Next().IsSome() || iter.Err() != nil {
+			t.Fail()
+		}
+	})
+	t.Run("not an array", func(t *testing.T) {
+		iter := iterator.JSONArray[int](strings.NewReader(`{"x":1}`))
+		if iter.Next().IsSome() || iter.Err() == nil {
+			t.Fail()
+		}
+	})
+	t.Run("truncated", func(t *testing.T) {
+		iter := iterator.JSONArray[int](strings.NewReader(`[1, 2`))
+		actual := iterator.Collect[int](iter)
+		if !reflect.DeepEqual(actual, []int{1, 2}) {
+			t.Fail()
+		}
+		if iter.Err() == nil {
+			t.Fail()
+		}
+	})
+	t.Run("wrong element type", func(t *testing.T) {
+		iter := iterator.JSONArray[int](strings.NewReader(`[1, "two", 3]`))
+		actual := iterator.Collect[int](iter)
+		if !reflect.DeepEqual(actual, []int{1}) {
+			t.Fail()
+		}
+		if iter.Err() == nil {
+			t.Fail()
+		}
+	})
+}
+
+func TestNDJSON(t *testing.T) {
+	t.Run("decodes values", func(t *testing.T) {
+		iter := iterator.NDJSON[jsonPoint](strings.NewReader("{\"x\":1,\"y\":2}\n{\"x\":3,\"y\":4}\n"))
+		actual := iterator.Collect[jsonPoint](iter)
+		if !reflect.DeepEqual(actual, []jsonPoint{{1, 2}, {3, 4}}) {
+			t.Fail()
+		}
+		if iter.Err() != nil {
+			t.Fail()
+		}
+	})
+	t.Run("malformed value", func(t *testing.T) {
+		iter := iterator.NDJSON[jsonPoint](strings.NewReader("{\"x\":1}\n{\"x\":\n"))
+		actual := iterator.Collect[jsonPoint](iter)
+		if !reflect.DeepEqual(actual, []jsonPoint{{1, 0}}) {
+			t.Fail()
+		}
+		if iter.Err() == nil {
+			t.Fail()
+		}
+	})
+}
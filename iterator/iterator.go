@@ -125,12 +125,64 @@ func TryFold[T any, A any, E any](
 	return result.Ok[A, E](a)
 }
 
+// TryFoldFlow is like TryFold, but f returns a ControlFlow rather than a Result.
+// Short-circuits if f returns Break, returning it. Otherwise, returns Continue with the final accumulator.
+func TryFoldFlow[T any, A any, B any](
+	iter Iterator[T], a A, f func(A, T) ControlFlow[B, A],
+) ControlFlow[B, A] {
+	for item := iter.Next(); item.IsSome(); item = iter.Next() {
+		flow := f(a, item.Unwrap())
+		if flow.IsBreak() {
+			return flow
+		}
+		a = flow.cont
+	}
+	return Continue[B](a)
+}
+
+// FoldWhile folds elements into an accumulator until f returns Break, returning the value it was given.
+// If f never returns Break, the entire iterator is consumed and the final accumulator is returned.
+// Elements after the one that caused the Break are not consumed.
+func FoldWhile[T any, A any](iter Iterator[T], a A, f func(A, T) ControlFlow[A, A]) A {
+	flow := TryFoldFlow(iter, a, f)
+	if flow.IsBreak() {
+		return flow.brk
+	}
+	return flow.cont
+}
+
+// Reduce folds every element into an accumulator, using the first element as the initial value.
+// Returns Nothing if the iterator is empty.
+// The entire iterator will be consumed by this.
+func Reduce[T any](iter Iterator[T], f func(T, T) T) option.Option[T] {
+	return option.Map(iter.Next(), func(first T) T {
+		return Fold(iter, first, f)
+	})
+}
+
+// TryReduce is like Reduce, but short-circuits if f returns Err, returning the error.
+// Returns Ok(Nothing) if the iterator is empty.
+func TryReduce[T any, E any](iter Iterator[T], f func(T, T) result.Result[T, E]) result.Result[option.Option[T], E] {
+	first := iter.Next()
+	if first.IsNothing() {
+		return result.Ok[option.Option[T], E](option.Nothing[T]())
+	}
+	res := TryFold(iter, first.Unwrap(), f)
+	if res.IsErr() {
+		return result.Err[option.Option[T]](res.UnwrapErr())
+	}
+	return result.Ok[option.Option[T], E](option.Some(res.Unwrap()))
+}
+
 // Advances the iterator by n and returns the nth next item.
 // Count starts from 0, so Nth(I, 0) returns the current element.
 // The iterator is not rewinded, so preceding elements will be discarded.
 // Subsequent calls (even to Nth(I, 0)) will return different values.
 // Returns Nothing if n is greater or equal to the length of the iterator.
 func Nth[T any](iter Iterator[T], n uint64) option.Option[T] {
+	if nth, ok := iter.(interface{ Nth(uint64) option.Option[T] }); ok {
+		return nth.Nth(n)
+	}
 	return option.AndThen(
 		AdvanceBy(iter, n).Ok(),
 		func(_ struct{}) option.Option[T] {
@@ -141,7 +193,12 @@ func Nth[T any](iter Iterator[T], n uint64) option.Option[T] {
 
 // Last returns the final element of the iterator, before it returns Nothing.
 // Returns Nothing if the iterator is empty.
+// If iter can iterate from the back without buffering, such as a Map over a range, the final element is taken from
+// the back without walking the iterator. Otherwise, the entire iterator is consumed, holding one element at a time.
 func Last[T any](iter Iterator[T]) option.Option[T] {
+	if de, ok := iter.(DoubleEndedIterator[T]); ok && !mayBufferBack(iter) {
+		return de.NextBack()
+	}
 	return Fold(iter, option.Nothing[T](),
 		func(_ option.Option[T], t T) option.Option[T] {
 			return option.Some(t)
@@ -174,6 +231,23 @@ func MaxBy[T any](iter Iterator[T], less func(T, T) bool) option.Option[T] {
 	)
 }
 
+// MaxByKey returns the element of the iterator for which key returns the maximum value.
+// key is called exactly once per element.
+// Returns the last element if multiple elements are equally maximal.
+// Returns Nothing if the iterator is empty.
+func MaxByKey[T any, K cmp.Ordered](iter Iterator[T], key func(T) K) option.Option[T] {
+	return option.Map(
+		MaxBy(Map(iter, func(t T) pair.Pair[T, K] {
+			return pair.From(t, key(t))
+		}), func(p1 pair.Pair[T, K], p2 pair.Pair[T, K]) bool {
+			return p1.Second < p2.Second
+		}),
+		func(p pair.Pair[T, K]) T {
+			return p.First
+		},
+	)
+}
+
 // Min returns the minimum element of the iterator.
 // Returns the first element if multiple elements are equally minimal.
 // Returns Nothing if the iterator is empty.
@@ -199,15 +273,66 @@ func MinBy[T any](iter Iterator[T], less func(T, T) bool) option.Option[T] {
 	)
 }
 
+// MinByKey returns the element of the iterator for which key returns the minimum value.
+// key is called exactly once per element.
+// Returns the first element if multiple elements are equally minimal.
+// Returns Nothing if the iterator is empty.
+func MinByKey[T any, K cmp.Ordered](iter Iterator[T], key func(T) K) option.Option[T] {
+	return option.Map(
+		MinBy(Map(iter, func(t T) pair.Pair[T, K] {
+			return pair.From(t, key(t))
+		}), func(p1 pair.Pair[T, K], p2 pair.Pair[T, K]) bool {
+			return p1.Second < p2.Second
+		}),
+		func(p pair.Pair[T, K]) T {
+			return p.First
+		},
+	)
+}
+
+// MinMax returns the minimum and maximum elements of the iterator, in a single pass.
+// As with Min and Max, the first minimal element and the last maximal element are returned.
+// Returns Nothing if the iterator is empty.
+func MinMax[T cmp.Ordered](iter Iterator[T]) option.Option[pair.Pair[T, T]] {
+	return MinMaxBy(iter, func(t1 T, t2 T) bool {
+		return t1 < t2
+	})
+}
+
+// MinMaxBy returns the minimum and maximum elements of the iterator with respect to the specified less function,
+// in a single pass.
+// less(a, b) should return true if a is less than b, and false otherwise.
+// As with MinBy and MaxBy, the first minimal element and the last maximal element are returned.
+// Returns Nothing if the iterator is empty.
+func MinMaxBy[T any](iter Iterator[T], less func(T, T) bool) option.Option[pair.Pair[T, T]] {
+	return Fold(iter, option.Nothing[pair.Pair[T, T]](),
+		func(o option.Option[pair.Pair[T, T]], t T) option.Option[pair.Pair[T, T]] {
+			val, ok := o.Get()
+			if !ok {
+				return option.Some(pair.From(t, t))
+			}
+			if less(t, val.First) {
+				val.First = t
+			}
+			if !less(t, val.Second) {
+				val.Second = t
+			}
+			return option.Some(val)
+		},
+	)
+}
+
 // Collect returns all the elements of the iterator into a slice.
+// The slice is pre-sized from the iterator's SizeHint, if it gives the length exactly.
 func Collect[T any](iter Iterator[T]) []T {
-	return Fold(iter, []T{}, func(a []T, t T) []T {
+	return Fold(iter, make([]T, 0, capacityHint(iter)), func(a []T, t T) []T {
 		return append(a, t)
 	})
 }
 
 // CollectInto collects the iterator into the given collection.
 // The provided collection is modified to hold the elements in iter.
+// The elements are gathered with Collect, so they are appended to the collection in a single pre-sized batch.
 func CollectInto[T any, C Collection[T]](iter Iterator[T], collection C) C {
 	collection.Append(Collect(iter)...)
 	return collection
@@ -253,9 +378,11 @@ func Position[T any](iter Iterator[T], pred func(T) bool) option.Option[uint64]
 }
 
 // Consumes an entire iterator of pairs, producing two collections, for the first and second elements respectively.
+// Both slices are pre-sized from the iterator's SizeHint, if it gives the length exactly.
 func Unzip[T any, U any](iter Iterator[pair.Pair[T, U]]) ([]T, []U) {
-	firstList := []T{}
-	secondList := []U{}
+	n := capacityHint(iter)
+	firstList := make([]T, 0, n)
+	secondList := make([]U, 0, n)
 	ForEach(iter, func(t pair.Pair[T, U]) {
 		firstList = append(firstList, t.First)
 		secondList = append(secondList, t.Second)
@@ -263,6 +390,19 @@ func Unzip[T any, U any](iter Iterator[pair.Pair[T, U]]) ([]T, []U) {
 	return firstList, secondList
 }
 
+// UnzipInto consumes an iterator of pairs, appending the first elements to first and the second to second.
+// Each element is appended as it is reached, without gathering the elements first.
+// The collections are modified to hold the elements, and returned.
+func UnzipInto[T any, U any, CT Collection[T], CU Collection[U]](
+	iter Iterator[pair.Pair[T, U]], first CT, second CU,
+) (CT, CU) {
+	ForEach(iter, func(p pair.Pair[T, U]) {
+		first.Append(p.First)
+		second.Append(p.Second)
+	})
+	return first, second
+}
+
 // IntoIterator is an interface representing something that can turn into an Iterator.
 type IntoIterator[T any] interface {
 	IntoIter() Iterator[T]
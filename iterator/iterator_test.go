@@ -8,6 +8,7 @@ import (
 	"github.com/sidkurella/goption/option"
 	"github.com/sidkurella/goption/pair"
 	"github.com/sidkurella/goption/result"
+	"github.com/sidkurella/goption/set"
 	"github.com/sidkurella/goption/sliceutil"
 )
 
@@ -298,6 +299,133 @@ func TestTryFold(t *testing.T) {
 	})
 }
 
+func TestTryFoldFlow(t *testing.T) {
+	t.Run("early exit", func(t *testing.T) {
+		iter := &fakeIterator{
+			elements: []int{1, 2, 3, 4, 5},
+		}
+		ret := iterator.TryFoldFlow[int](iter, 1,
+			func(a int, t int) iterator.ControlFlow[string, int] {
+				if t < 4 {
+					return iterator.Continue[string](a * t)
+				}
+				return iterator.Break[string, int]("stopped")
+			},
+		)
+		if ret.BreakValue().Unwrap() != "stopped" {
+			t.Fail()
+		}
+		if iter.Next().Unwrap() != 5 { // Iterator should be at the element after the Break.
+			t.Fail()
+		}
+	})
+	t.Run("no early exit", func(t *testing.T) {
+		iter := &fakeIterator{
+			elements: []int{1, 2, 3, 4, 5},
+		}
+		ret := iterator.TryFoldFlow[int](iter, 1,
+			func(a int, t int) iterator.ControlFlow[string, int] {
+				return iterator.Continue[string](a * t)
+			},
+		)
+		if ret.ContinueValue().Unwrap() != 120 {
+			t.Fail()
+		}
+	})
+}
+
+func TestFoldWhile(t *testing.T) {
+	t.Run("early exit", func(t *testing.T) {
+		iter := &fakeIterator{
+			elements: []int{1, 2, 3, 4, 5},
+		}
+		ret := iterator.FoldWhile[int](iter, 0,
+			func(a int, t int) iterator.ControlFlow[int, int] {
+				if a+t > 6 {
+					return iterator.Break[int, int](a)
+				}
+				return iterator.Continue[int](a + t)
+			},
+		)
+		if ret != 6 {
+			t.Fail()
+		}
+		if iter.Next().Unwrap() != 5 {
+			t.Fail()
+		}
+	})
+	t.Run("no early exit", func(t *testing.T) {
+		iter := &fakeIterator{
+			elements: []int{1, 2, 3, 4, 5},
+		}
+		ret := iterator.FoldWhile[int](iter, 0,
+			func(a int, t int) iterator.ControlFlow[int, int] {
+				return iterator.Continue[int](a + t)
+			},
+		)
+		if ret != 15 {
+			t.Fail()
+		}
+	})
+}
+
+func TestReduce(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		iter := &fakeIterator{}
+		if iterator.Reduce[int](iter, func(a, b int) int { return a + b }).IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("non-empty", func(t *testing.T) {
+		iter := &fakeIterator{
+			elements: []int{3, 1, 4, 1, 5},
+		}
+		if iterator.Reduce[int](iter, func(a, b int) int { return max(a, b) }).Unwrap() != 5 {
+			t.Fail()
+		}
+	})
+}
+
+func TestTryReduce(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		iter := &fakeIterator{}
+		ret := iterator.TryReduce[int](iter, func(a, b int) result.Result[int, string] {
+			return result.Ok[int, string](a + b)
+		})
+		if ret.Unwrap().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("early exit", func(t *testing.T) {
+		iter := &fakeIterator{
+			elements: []int{1, 2, -3, 4},
+		}
+		ret := iterator.TryReduce[int](iter, func(a, b int) result.Result[int, string] {
+			if b < 0 {
+				return result.Err[int]("negative")
+			}
+			return result.Ok[int, string](a + b)
+		})
+		if ret.UnwrapErr() != "negative" {
+			t.Fail()
+		}
+		if iter.Next().Unwrap() != 4 {
+			t.Fail()
+		}
+	})
+	t.Run("no early exit", func(t *testing.T) {
+		iter := &fakeIterator{
+			elements: []int{1, 2, 3, 4},
+		}
+		ret := iterator.TryReduce[int](iter, func(a, b int) result.Result[int, string] {
+			return result.Ok[int, string](a + b)
+		})
+		if ret.Unwrap().Unwrap() != 10 {
+			t.Fail()
+		}
+	})
+}
+
 func TestNth(t *testing.T) {
 	t.Run("exists", func(t *testing.T) {
 		iter := &fakeIterator{
@@ -353,6 +481,27 @@ func TestLast(t *testing.T) {
 			t.Fail()
 		}
 	})
+	t.Run("from the back", func(t *testing.T) {
+		calls := 0
+		iter := iterator.Map[int](iterator.Range(0, 1000), func(x int) int {
+			calls++
+			return x * 2
+		})
+		if iterator.Last[int](iter).Unwrap() != 1998 || calls != 1 {
+			t.Fail()
+		}
+	})
+	t.Run("forward-only source is streamed", func(t *testing.T) {
+		calls := 0
+		iter := iterator.Map[int](&fakeIterator{elements: []int{1, 2, 3}}, func(x int) int {
+			calls++
+			return x * 2
+		})
+		// Buffering the source to take the last element from the back would only call f once.
+		if iterator.Last[int](iter).Unwrap() != 6 || calls != 3 {
+			t.Fail()
+		}
+	})
 }
 
 func TestMax(t *testing.T) {
@@ -513,6 +662,29 @@ func TestCollect(t *testing.T) {
 	})
 }
 
+// overstatedIterator reports a far larger lower bound on its length than it has, with no upper bound.
+type overstatedIterator struct {
+	fakeIterator
+}
+
+func (o *overstatedIterator) SizeHint() (uint64, option.Option[uint64]) {
+	return 1 << 40, option.Nothing[uint64]()
+}
+
+func TestCollect_Capacity(t *testing.T) {
+	t.Run("exact", func(t *testing.T) {
+		if res := iterator.Collect[int](iterator.Range(0, 100)); cap(res) != 100 {
+			t.Errorf("expected capacity 100, got %v", cap(res))
+		}
+	})
+	t.Run("inexact is not preallocated", func(t *testing.T) {
+		res := iterator.Collect[int](&overstatedIterator{fakeIterator{elements: []int{1, 2, 3}}})
+		if !reflect.DeepEqual(res, []int{1, 2, 3}) || cap(res) > 16 {
+			t.Errorf("got %v with capacity %v", res, cap(res))
+		}
+	})
+}
+
 func TestCollectInto(t *testing.T) {
 	t.Run("non-empty", func(t *testing.T) {
 		iter := &fakeIterator{
@@ -611,3 +783,77 @@ func TestUnzip(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestUnzipInto(t *testing.T) {
+	iter := &fakePairIterator{
+		elements: []pair.Pair[int, string]{
+			{First: 1, Second: "a"},
+			{First: 2, Second: "b"},
+			{First: 1, Second: "a"},
+		},
+	}
+	first, second := iterator.UnzipInto[int, string](iter, &fakeCollection{elems: []int{0}}, set.New[string]())
+	if !reflect.DeepEqual(first.elems, []int{0, 1, 2, 1}) {
+		t.Fail()
+	}
+	if second.Len() != 2 || !second.Contains("a") || !second.Contains("b") {
+		t.Fail()
+	}
+}
+
+func TestUnzipInto_Streams(t *testing.T) {
+	first, second := &fakeCollection{}, &fakeCollection{}
+	pulled := 0
+	iter := iterator.Inspect[pair.Pair[int, int]](iterator.Zip[int, int](iterator.Range(0, 3), iterator.Range(3, 6)),
+		func(pair.Pair[int, int]) {
+			if len(first.elems) != pulled || len(second.elems) != pulled { // Earlier elements are already appended.
+				t.Errorf("expected %v elements appended before pulling the next", pulled)
+			}
+			pulled++
+		},
+	)
+	iterator.UnzipInto[int, int](iter, first, second)
+	if !reflect.DeepEqual(first.elems, []int{0, 1, 2}) || !reflect.DeepEqual(second.elems, []int{3, 4, 5}) {
+		t.Fail()
+	}
+}
+
+func TestMaxByKey(t *testing.T) {
+	iter := &fakeStringIterator{
+		elements: []string{"one", "three", "two", "seven"},
+	}
+	max := iterator.MaxByKey[string](iter, func(s string) int {
+		return len(s)
+	})
+	if max.Unwrap() != "seven" {
+		t.Fail()
+	}
+}
+
+func TestMinByKey(t *testing.T) {
+	iter := &fakeStringIterator{
+		elements: []string{"three", "one", "two"},
+	}
+	min := iterator.MinByKey[string](iter, func(s string) int {
+		return len(s)
+	})
+	if min.Unwrap() != "one" {
+		t.Fail()
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	t.Run("non-empty", func(t *testing.T) {
+		iter := &fakeIterator{
+			elements: []int{3, 1, 4, 1, 5, 9, 2},
+		}
+		if iterator.MinMax[int](iter).Unwrap() != pair.From(1, 9) {
+			t.Fail()
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		if iterator.MinMax[int](&fakeIterator{}).IsSome() {
+			t.Fail()
+		}
+	})
+}
@@ -27,3 +27,23 @@ func (m *mapIterator[T, U]) Next() option.Option[U] {
 		},
 	)
 }
+
+// Returns the next element from the back of the inner iterator, mapped by f.
+// If the inner iterator is not a DoubleEndedIterator, its remaining elements are buffered first.
+func (m *mapIterator[T, U]) NextBack() option.Option[U] {
+	return option.Map(backOf(&m.inner).NextBack(), m.f)
+}
+
+func (m *mapIterator[T, U]) buffersBack() bool {
+	return mayBufferBack(m.inner)
+}
+
+// Returns the bounds on the remaining length, which are those of the inner iterator.
+func (m *mapIterator[T, U]) SizeHint() (uint64, option.Option[uint64]) {
+	return SizeHint(m.inner)
+}
+
+// Close closes the inner iterator if it implements Closer.
+func (m *mapIterator[T, U]) Close() {
+	closeIter(m.inner)
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/sliceutil"
 )
 
 func TestMap(t *testing.T) {
@@ -21,3 +22,34 @@ func TestMap(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestMap_NextBack(t *testing.T) {
+	t.Run("double-ended inner", func(t *testing.T) {
+		expected := []string{"40", "30", "20", "10"}
+		iter := iterator.Map(sliceutil.Iter([]int{1, 2, 3, 4}), func(t int) string {
+			return strconv.Itoa(t * 10)
+		})
+		actual := iterator.Collect[string](iterator.Rev[string](iter))
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fail()
+		}
+	})
+	t.Run("buffered inner", func(t *testing.T) {
+		i1 := &fakeIterator{
+			elements: []int{1, 2, 3, 4},
+		}
+		iter := iterator.Map[int](i1, func(t int) string {
+			return strconv.Itoa(t * 10)
+		})
+		if iter.Next().Unwrap() != "10" {
+			t.Fail()
+		}
+		if iter.NextBack().Unwrap() != "40" {
+			t.Fail()
+		}
+		actual := iterator.Collect[string](iter)
+		if !reflect.DeepEqual(actual, []string{"20", "30"}) {
+			t.Fail()
+		}
+	})
+}
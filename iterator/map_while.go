@@ -26,3 +26,8 @@ func (m *mapWhileIterator[T, U]) Next() option.Option[U] {
 		},
 	)
 }
+
+// Close closes the inner iterator if it implements Closer.
+func (m *mapWhileIterator[T, U]) Close() {
+	closeIter(m.inner)
+}
@@ -0,0 +1,113 @@
+package iterator
+
+import (
+	"cmp"
+	"container/heap"
+
+	"github.com/sidkurella/goption/option"
+)
+
+// mergeHead is the next element of one of the merged iterators.
+type mergeHead[T any] struct {
+	val   T
+	index int // The position of the source iterator in the merge, used to break ties.
+}
+
+// mergeHeap is a min-heap of the next element of each merged iterator.
+type mergeHeap[T any] struct {
+	heads   []mergeHead[T]
+	compare func(T, T) int
+}
+
+func (h *mergeHeap[T]) Len() int {
+	return len(h.heads)
+}
+
+func (h *mergeHeap[T]) Less(i int, j int) bool {
+	c := h.compare(h.heads[i].val, h.heads[j].val)
+	return c < 0 || (c == 0 && h.heads[i].index < h.heads[j].index)
+}
+
+func (h *mergeHeap[T]) Swap(i int, j int) {
+	h.heads[i], h.heads[j] = h.heads[j], h.heads[i]
+}
+
+func (h *mergeHeap[T]) Push(x any) {
+	h.heads = append(h.heads, x.(mergeHead[T]))
+}
+
+func (h *mergeHeap[T]) Pop() any {
+	last := h.heads[len(h.heads)-1]
+	h.heads = h.heads[:len(h.heads)-1]
+	return last
+}
+
+type mergeSortedIterator[T any] struct {
+	iters   []Iterator[T]
+	heap    *mergeHeap[T]
+	started bool
+}
+
+// Creates an iterator that merges iterators that are each sorted in ascending order into a single sorted iterator.
+// See MergeSortedBy.
+func MergeSorted[T cmp.Ordered](iters ...Iterator[T]) *mergeSortedIterator[T] {
+	return MergeSortedBy(cmp.Compare[T], iters...)
+}
+
+// Creates an iterator that merges iterators that are each sorted with respect to compare into a single sorted
+// iterator. compare(a, b) should return a negative number if a < b, zero if a == b, and a positive number if a > b.
+// The merge holds one pending element per iterator in a heap, so each element costs O(log k) for k iterators.
+// Equal elements are yielded in the order of the iterators they came from.
+func MergeSortedBy[T any](compare func(T, T) int, iters ...Iterator[T]) *mergeSortedIterator[T] {
+	return &mergeSortedIterator[T]{
+		iters: iters,
+		heap: &mergeHeap[T]{
+			heads:   make([]mergeHead[T], 0, len(iters)),
+			compare: compare,
+		},
+		started: false,
+	}
+}
+
+func (m *mergeSortedIterator[T]) Next() option.Option[T] {
+	if !m.started {
+		m.started = true
+		for i, iter := range m.iters {
+			if val, ok := iter.Next().Get(); ok {
+				m.heap.heads = append(m.heap.heads, mergeHead[T]{val: val, index: i})
+			}
+		}
+		heap.Init(m.heap)
+	}
+	if m.heap.Len() == 0 {
+		return option.Nothing[T]()
+	}
+	head := m.heap.heads[0]
+	if val, ok := m.iters[head.index].Next().Get(); ok {
+		m.heap.heads[0].val = val
+		heap.Fix(m.heap, 0)
+	} else {
+		heap.Pop(m.heap)
+	}
+	return option.Some(head.val)
+}
+
+// Returns the bounds on the remaining length, which is the sum of the bounds of the merged iterators.
+func (m *mergeSortedIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	lower, upper := uint64(m.heap.Len()), option.Some(uint64(m.heap.Len()))
+	for _, iter := range m.iters {
+		l, u := SizeHint(iter)
+		lower, upper = saturatingAdd(lower, l), addUpper(upper, u)
+	}
+	return lower, upper
+}
+
+// Close closes each of the merged iterators that implements Closer.
+// Elements already pulled from them are discarded.
+func (m *mergeSortedIterator[T]) Close() {
+	for _, iter := range m.iters {
+		closeIter(iter)
+	}
+	m.started = true
+	m.heap.heads = m.heap.heads[:0]
+}
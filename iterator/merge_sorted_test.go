@@ -0,0 +1,51 @@
+package iterator_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+func TestMergeSorted(t *testing.T) {
+	t.Run("merge", func(t *testing.T) {
+		iter := iterator.MergeSorted[int](
+			sliceutil.Iter([]int{1, 4, 7}),
+			&fakeIterator{},
+			iterator.RangeBy(0, 10, 3),
+			&fakeIterator{elements: []int{2, 5, 8, 11}},
+		)
+		lower, _ := iterator.SizeHint[int](iter)
+		if lower != 7 {
+			t.Fail()
+		}
+		expected := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 11}
+		actual := iterator.Collect[int](iter)
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fail()
+		}
+	})
+	t.Run("no iterators", func(t *testing.T) {
+		iter := iterator.MergeSorted[int]()
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+}
+
+func TestMergeSortedBy(t *testing.T) {
+	byLen := func(a string, b string) int {
+		return len(a) - len(b)
+	}
+	iter := iterator.MergeSortedBy(byLen,
+		sliceutil.Iter([]string{"a", "ccc"}),
+		sliceutil.Iter([]string{"b", "dd"}),
+	)
+	expected := []string{"a", "b", "dd", "ccc"} // Ties are broken by iterator order.
+	actual := iterator.Collect[string](iter)
+	if strings.Join(expected, ",") != strings.Join(actual, ",") {
+		t.Fail()
+	}
+}
@@ -0,0 +1,34 @@
+package iterator
+
+import "github.com/sidkurella/goption/option"
+
+// Sum adds up all the elements of the iterator.
+// The empty iterator sums to 0. Integer sums wrap on overflow, as with the + operator.
+func Sum[T numeric](iter Iterator[T]) T {
+	return Fold(iter, T(0), func(a T, t T) T {
+		return a + t
+	})
+}
+
+// Product multiplies all the elements of the iterator together.
+// The empty iterator has a product of 1. Integer products wrap on overflow, as with the * operator.
+func Product[T numeric](iter Iterator[T]) T {
+	return Fold(iter, T(1), func(a T, t T) T {
+		return a * t
+	})
+}
+
+// Mean returns the arithmetic mean of the elements of the iterator.
+// The mean is accumulated incrementally in float64, so it does not overflow for large integer inputs.
+// Returns Nothing if the iterator is empty.
+func Mean[T numeric](iter Iterator[T]) option.Option[float64] {
+	n := uint64(0)
+	mean := Fold(iter, float64(0), func(a float64, t T) float64 {
+		n++
+		return a + (float64(t)-a)/float64(n)
+	})
+	if n == 0 {
+		return option.Nothing[float64]()
+	}
+	return option.Some(mean)
+}
@@ -0,0 +1,39 @@
+package iterator_test
+
+import (
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+func TestSum(t *testing.T) {
+	if iterator.Sum[int](iterator.RangeInclusive(1, 100)) != 5050 {
+		t.Fail()
+	}
+	if iterator.Sum[float64](sliceutil.Iter([]float64{0.5, 0.25})) != 0.75 {
+		t.Fail()
+	}
+	if iterator.Sum[int](&fakeIterator{}) != 0 {
+		t.Fail()
+	}
+}
+
+func TestProduct(t *testing.T) {
+	if iterator.Product[int](iterator.RangeInclusive(1, 5)) != 120 {
+		t.Fail()
+	}
+	if iterator.Product[int](&fakeIterator{}) != 1 {
+		t.Fail()
+	}
+}
+
+func TestMean(t *testing.T) {
+	if iterator.Mean[int](&fakeIterator{elements: []int{1, 2, 3, 4}}) != option.Some(2.5) {
+		t.Fail()
+	}
+	if iterator.Mean[int](&fakeIterator{}).IsSome() {
+		t.Fail()
+	}
+}
@@ -0,0 +1,255 @@
+package iterator
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/sidkurella/goption/option"
+)
+
+// A parallel adapter's unit of work: an element of the source iterator and its position.
+type parJob[T any] struct {
+	index uint64
+	value T
+}
+
+// The outcome of a parallel adapter's unit of work.
+type parResult[U any] struct {
+	index    uint64
+	value    option.Option[U]
+	panicked bool
+	panicVal any
+}
+
+// An iterator that applies f to the elements of a source iterator on a pool of worker goroutines.
+type parIterator[T any, U any] struct {
+	source  Iterator[T]
+	ctx     context.Context
+	cancel  context.CancelFunc
+	results chan parResult[U]
+	tokens  chan struct{} // Bounds the number of elements pulled from the source but not yet yielded.
+	ordered bool
+	pending map[uint64]parResult[U] // Results that arrived ahead of their turn, when ordered.
+	next    uint64                  // The index of the next result to yield, when ordered.
+	done    bool
+	err     error
+}
+
+// Creates an iterator that maps the elements of iter with f on a pool of worker goroutines.
+// Elements are yielded in the same order as iter. See ParFilterMap for details on concurrency and cleanup.
+func ParMap[T any, U any](ctx context.Context, iter Iterator[T], workers int, f func(T) U) *parIterator[T, U] {
+	return newParIterator(ctx, iter, workers, func(t T) option.Option[U] {
+		return option.Some(f(t))
+	}, true)
+}
+
+// Creates an iterator that maps the elements of iter with f on a pool of worker goroutines.
+// Elements are yielded as soon as they are ready, which may differ from the order of iter.
+// See ParFilterMap for details on concurrency and cleanup.
+func ParMapUnordered[T any, U any](ctx context.Context, iter Iterator[T], workers int, f func(T) U) *parIterator[T, U] {
+	return newParIterator(ctx, iter, workers, func(t T) option.Option[U] {
+		return option.Some(f(t))
+	}, false)
+}
+
+// Creates an iterator that yields the elements of iter that pass pred, evaluating pred on a pool of worker
+// goroutines. Elements are yielded in the same order as iter. See ParFilterMap for details on concurrency and cleanup.
+func ParFilter[T any](ctx context.Context, iter Iterator[T], workers int, pred func(T) bool) *parIterator[T, T] {
+	return ParFilterMap(ctx, iter, workers, func(t T) option.Option[T] {
+		if pred(t) {
+			return option.Some(t)
+		}
+		return option.Nothing[T]()
+	})
+}
+
+// Creates an iterator that both filters and maps the elements of iter with f on a pool of worker goroutines.
+// Elements are yielded in the same order as iter.
+//
+// The source iterator is pulled from a single background goroutine, so it need not be safe for concurrent use,
+// while f is called concurrently from up to workers goroutines. A value of workers less than 1 is treated as
+// runtime.GOMAXPROCS(0). At most 2*workers elements are pulled from the source ahead of the consumer.
+//
+// If f (or the source iterator) panics, the panic is re-raised from Next on the consuming goroutine.
+// If ctx is cancelled, the iterator stops early and returns Nothing; Err then reports the cause.
+//
+// The returned iterator holds goroutines. As with FromSeq, defer Close immediately after creating it; Close
+// cancels outstanding work and waits for the goroutines to exit, so none are leaked if the consumer stops early.
+// The source iterator is closed along with it.
+func ParFilterMap[T any, U any](
+	ctx context.Context, iter Iterator[T], workers int, f func(T) option.Option[U],
+) *parIterator[T, U] {
+	return newParIterator(ctx, iter, workers, f, true)
+}
+
+// ParForEach runs f on each element of iter on a pool of worker goroutines, returning once every element has
+// been processed. f is called concurrently and in no particular order.
+// If f panics, the panic is re-raised on the calling goroutine once the pool has stopped.
+// Returns the context's error if ctx was cancelled before every element was processed.
+func ParForEach[T any](ctx context.Context, iter Iterator[T], workers int, f func(T)) error {
+	p := newParIterator(ctx, iter, workers, func(t T) option.Option[struct{}] {
+		f(t)
+		return option.Nothing[struct{}]()
+	}, false)
+	defer p.Close()
+	ForEach[struct{}](p, func(struct{}) {})
+	return p.Err()
+}
+
+func newParIterator[T any, U any](
+	ctx context.Context, iter Iterator[T], workers int, f func(T) option.Option[U], ordered bool,
+) *parIterator[T, U] {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	p := &parIterator[T, U]{
+		source:  iter,
+		ctx:     ctx,
+		cancel:  cancel,
+		results: make(chan parResult[U], workers),
+		tokens:  make(chan struct{}, 2*workers),
+		ordered: ordered,
+		pending: map[uint64]parResult[U]{},
+		next:    0,
+		done:    false,
+		err:     nil,
+	}
+	jobs := make(chan parJob[T], workers)
+	var wg sync.WaitGroup
+	wg.Add(workers + 1)
+	go func() {
+		defer wg.Done()
+		p.feed(iter, jobs)
+	}()
+	for range workers {
+		go func() {
+			defer wg.Done()
+			p.work(f, jobs)
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(p.results)
+	}()
+	return p
+}
+
+// feed pulls elements from the source iterator and hands them to the workers, until the source is exhausted or
+// the iterator is cancelled.
+func (p *parIterator[T, U]) feed(iter Iterator[T], jobs chan<- parJob[T]) {
+	defer close(jobs)
+	defer func() {
+		if r := recover(); r != nil {
+			p.send(parResult[U]{panicked: true, panicVal: r})
+		}
+	}()
+	for i := uint64(0); ; i++ {
+		select {
+		case p.tokens <- struct{}{}:
+		case <-p.ctx.Done():
+			return
+		}
+		val, ok := iter.Next().Get()
+		if !ok {
+			return
+		}
+		select {
+		case jobs <- parJob[T]{index: i, value: val}:
+		case <-p.ctx.Done():
+			return
+		}
+	}
+}
+
+// work applies f to each job until there are no more jobs or the iterator is cancelled.
+func (p *parIterator[T, U]) work(f func(T) option.Option[U], jobs <-chan parJob[T]) {
+	for job := range jobs {
+		res := parResult[U]{index: job.index}
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					res.panicked = true
+					res.panicVal = r
+				}
+			}()
+			res.value = f(job.value)
+		}()
+		if !p.send(res) {
+			return
+		}
+	}
+}
+
+// send delivers a result to the consumer. Returns false if the iterator was cancelled first.
+func (p *parIterator[T, U]) send(res parResult[U]) bool {
+	select {
+	case p.results <- res:
+		return true
+	case <-p.ctx.Done():
+		return false
+	}
+}
+
+// receive returns the next result to yield, in input order if the iterator is ordered.
+// Returns false once all results have been delivered, or the iterator was cancelled.
+func (p *parIterator[T, U]) receive() (parResult[U], bool) {
+	for {
+		if res, ok := p.pending[p.next]; ok {
+			delete(p.pending, p.next)
+			p.next++
+			return res, true
+		}
+		res, ok := <-p.results
+		if !ok {
+			return res, false
+		}
+		if res.panicked || !p.ordered {
+			return res, true
+		}
+		p.pending[res.index] = res
+	}
+}
+
+func (p *parIterator[T, U]) Next() option.Option[U] {
+	for !p.done {
+		res, ok := p.receive()
+		if !ok {
+			p.err = p.ctx.Err()
+			p.Close()
+			break
+		}
+		if res.panicked {
+			p.Close()
+			panic(res.panicVal)
+		}
+		<-p.tokens
+		if res.value.IsSome() {
+			return res.value
+		}
+	}
+	return option.Nothing[U]()
+}
+
+// Err returns the error that stopped the iterator early, if its context was cancelled before the source was
+// exhausted. Returns nil otherwise, including after an early Close.
+func (p *parIterator[T, U]) Err() error {
+	return p.err
+}
+
+// Close cancels any outstanding work and waits for the iterator's goroutines to exit.
+// Once they have, the source iterator is closed if it implements Closer.
+// After Close is called, Next will return Nothing.
+// It is safe to call Close multiple times or after the iterator is exhausted.
+func (p *parIterator[T, U]) Close() {
+	if p.done {
+		return
+	}
+	p.done = true
+	p.cancel()
+	// Drain until every goroutine has exited, which closes the results channel.
+	for range p.results {
+	}
+	closeIter(p.source)
+}
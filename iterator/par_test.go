@@ -0,0 +1,115 @@
+package iterator_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+func TestParMap(t *testing.T) {
+	t.Run("ordered", func(t *testing.T) {
+		iter := iterator.ParMap(context.Background(), iterator.Range(0, 100), 4, func(i int) int {
+			time.Sleep(time.Duration(100-i) * time.Microsecond) // Finish out of order.
+			return i * 2
+		})
+		defer iter.Close()
+		expected := iterator.Collect[int](iterator.RangeBy(0, 200, 2))
+		actual := iterator.Collect[int](iter)
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fail()
+		}
+		if iter.Err() != nil {
+			t.Fail()
+		}
+	})
+	t.Run("unordered", func(t *testing.T) {
+		iter := iterator.ParMapUnordered(context.Background(), iterator.Range(0, 100), 4, func(i int) int {
+			return i * 2
+		})
+		defer iter.Close()
+		actual := iterator.Collect[int](iter)
+		slices.Sort(actual)
+		expected := iterator.Collect[int](iterator.RangeBy(0, 200, 2))
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fail()
+		}
+	})
+	t.Run("chains with take", func(t *testing.T) {
+		var calls atomic.Int64
+		iter := iterator.ParMap(context.Background(), iterator.Range(0, 1000000), 2, func(i int) int {
+			calls.Add(1)
+			return i
+		})
+		defer iter.Close()
+		actual := iterator.Collect[int](iterator.Take[int](iter, 3))
+		if !reflect.DeepEqual([]int{0, 1, 2}, actual) {
+			t.Fail()
+		}
+		iter.Close()
+		if calls.Load() > 10 { // Only a bounded number of elements are pulled ahead of the consumer.
+			t.Errorf("expected at most 10 calls, got %v", calls.Load())
+		}
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("panic", func(t *testing.T) {
+		iter := iterator.ParMap(context.Background(), iterator.Range(0, 10), 2, func(i int) int {
+			if i == 5 {
+				panic("boom")
+			}
+			return i
+		})
+		defer iter.Close()
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("expected panic boom, got %v", r)
+			}
+		}()
+		iterator.Collect[int](iter)
+		t.Error("expected panic")
+	})
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		iter := iterator.ParMap(ctx, iterator.Range(0, 1000000), 2, func(i int) int {
+			return i
+		})
+		defer iter.Close()
+		if iter.Next().IsNothing() {
+			t.Fail()
+		}
+		cancel()
+		iterator.Count[int](iter) // Drains whatever was already in flight, then stops.
+		if !errors.Is(iter.Err(), context.Canceled) {
+			t.Fail()
+		}
+	})
+}
+
+func TestParFilter(t *testing.T) {
+	iter := iterator.ParFilter(context.Background(), iterator.Range(0, 20), 3, func(i int) bool {
+		return i%3 == 0
+	})
+	defer iter.Close()
+	expected := []int{0, 3, 6, 9, 12, 15, 18}
+	actual := iterator.Collect[int](iter)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+}
+
+func TestParForEach(t *testing.T) {
+	var sum atomic.Int64
+	err := iterator.ParForEach(context.Background(), iterator.RangeInclusive(1, 100), 4, func(i int) {
+		sum.Add(int64(i))
+	})
+	if err != nil || sum.Load() != 5050 {
+		t.Fail()
+	}
+}
@@ -0,0 +1,123 @@
+package iterator
+
+import "github.com/sidkurella/goption/option"
+
+// PeekableIterator is an iterator that can look at its next element without consuming it, such as one returned by
+// Peekable.
+type PeekableIterator[T any] interface {
+	Iterator[T]
+	Peek() option.Option[T]
+	NextIf(pred func(T) bool) option.Option[T]
+}
+
+// An iterator that can look ahead at upcoming elements without consuming them.
+type peekableIterator[T any] struct {
+	inner  Iterator[T]
+	peeked []T  // Elements pulled from inner but not yet returned, in order.
+	done   bool // Whether inner returned Nothing after the peeked elements.
+}
+
+// Creates an iterator that allows looking at upcoming elements with Peek and PeekN without consuming them.
+// Peeked elements are buffered and returned by subsequent calls to Next.
+func Peekable[T any](inner Iterator[T]) *peekableIterator[T] {
+	return &peekableIterator[T]{
+		inner:  inner,
+		peeked: nil,
+		done:   false,
+	}
+}
+
+// Returns the next element, taking it from the peeked elements first.
+// If peeking observed the end of the inner iterator, that Nothing is returned once before the inner iterator is
+// consulted again.
+func (p *peekableIterator[T]) Next() option.Option[T] {
+	if len(p.peeked) > 0 {
+		ret := p.peeked[0]
+		// Shift rather than reslice, so that the buffer is reused and does not keep returned elements alive.
+		var zero T
+		n := copy(p.peeked, p.peeked[1:])
+		p.peeked[n] = zero
+		p.peeked = p.peeked[:n]
+		return option.Some(ret)
+	}
+	if p.done {
+		p.done = false
+		return option.Nothing[T]()
+	}
+	return p.inner.Next()
+}
+
+// fill pulls from the inner iterator until n elements have been peeked, or the inner iterator returns Nothing.
+func (p *peekableIterator[T]) fill(n uint64) {
+	for !p.done && uint64(len(p.peeked)) < n {
+		val, ok := p.inner.Next().Get()
+		if !ok {
+			p.done = true
+			return
+		}
+		p.peeked = append(p.peeked, val)
+	}
+}
+
+// Peek returns the next element of the iterator without consuming it.
+// Returns Nothing if the iterator is exhausted.
+func (p *peekableIterator[T]) Peek() option.Option[T] {
+	return p.PeekN(0)
+}
+
+// PeekMut returns a pointer to the next element of the iterator without consuming it.
+// Modifying the pointed-to value changes the element that the next call to Next will return.
+// Returns Nothing if the iterator is exhausted.
+func (p *peekableIterator[T]) PeekMut() option.Option[*T] {
+	p.fill(1)
+	if len(p.peeked) == 0 {
+		return option.Nothing[*T]()
+	}
+	return option.Some(&p.peeked[0])
+}
+
+// PeekN returns the nth upcoming element of the iterator without consuming any elements.
+// Count starts from 0, so PeekN(0) is equivalent to Peek().
+// Returns Nothing if the iterator has n or fewer elements remaining.
+func (p *peekableIterator[T]) PeekN(n uint64) option.Option[T] {
+	p.fill(n + 1)
+	if uint64(len(p.peeked)) <= n {
+		return option.Nothing[T]()
+	}
+	return option.Some(p.peeked[n])
+}
+
+// NextIf consumes and returns the next element of the iterator if pred returns true for it.
+// Otherwise, the element is left in place and Nothing is returned.
+func (p *peekableIterator[T]) NextIf(pred func(T) bool) option.Option[T] {
+	val, ok := p.Peek().Get()
+	if ok && pred(val) {
+		return p.Next()
+	}
+	return option.Nothing[T]()
+}
+
+// NextIfEq consumes and returns the next element of p if it is equal to expected.
+// Otherwise, the element is left in place and Nothing is returned.
+func NextIfEq[T comparable](p PeekableIterator[T], expected T) option.Option[T] {
+	return p.NextIf(func(t T) bool {
+		return t == expected
+	})
+}
+
+// Returns the bounds on the remaining length, including any peeked elements.
+func (p *peekableIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	peeked := uint64(len(p.peeked))
+	if p.done {
+		return exactSizeHint(peeked)
+	}
+	lower, upper := SizeHint(p.inner)
+	return saturatingAdd(lower, peeked), addUpper(upper, option.Some(peeked))
+}
+
+// Close closes the inner iterator if it implements Closer, and discards any peeked elements.
+func (p *peekableIterator[T]) Close() {
+	closeIter(p.inner)
+	p.peeked = nil
+	p.done = false
+}
@@ -0,0 +1,114 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+	"unicode"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/stringutil"
+)
+
+func TestPeekable(t *testing.T) {
+	t.Run("peek does not consume", func(t *testing.T) {
+		iter := iterator.Peekable[int](&fakeIterator{elements: []int{1, 2, 3}})
+		if iter.Peek() != option.Some(1) || iter.Peek() != option.Some(1) {
+			t.Fail()
+		}
+		if iter.Next() != option.Some(1) {
+			t.Fail()
+		}
+		if !reflect.DeepEqual(iterator.Collect[int](iter), []int{2, 3}) {
+			t.Fail()
+		}
+		if iter.Peek().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("peek mut", func(t *testing.T) {
+		iter := iterator.Peekable[int](&fakeIterator{elements: []int{1, 2}})
+		*iter.PeekMut().Unwrap() = 10
+		if !reflect.DeepEqual(iterator.Collect[int](iter), []int{10, 2}) {
+			t.Fail()
+		}
+		if iter.PeekMut().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("peek n", func(t *testing.T) {
+		iter := iterator.Peekable[int](&fakeIterator{elements: []int{1, 2, 3}})
+		if iter.PeekN(2) != option.Some(3) {
+			t.Fail()
+		}
+		if iter.PeekN(3).IsSome() {
+			t.Fail()
+		}
+		if iter.PeekN(0) != option.Some(1) {
+			t.Fail()
+		}
+		if !reflect.DeepEqual(iterator.Collect[int](iter), []int{1, 2, 3}) {
+			t.Fail()
+		}
+	})
+	t.Run("size hint", func(t *testing.T) {
+		iter := iterator.Peekable[int](iterator.Range(0, 5))
+		iter.PeekN(1)
+		lower, upper := iterator.SizeHint[int](iter)
+		if lower != 5 || upper != option.Some(uint64(5)) {
+			t.Fail()
+		}
+	})
+}
+
+func TestPeekable_NextIf(t *testing.T) {
+	iter := iterator.Peekable[rune](stringutil.Iter("123abc"))
+	digits := []rune{}
+	for d := iter.NextIf(unicode.IsDigit); d.IsSome(); d = iter.NextIf(unicode.IsDigit) {
+		digits = append(digits, d.Unwrap())
+	}
+	if string(digits) != "123" {
+		t.Fail()
+	}
+	if iter.Next() != option.Some('a') {
+		t.Fail()
+	}
+}
+
+func TestNextIfEq(t *testing.T) {
+	iter := iterator.Peekable[rune](stringutil.Iter("ab"))
+	if iterator.NextIfEq(iter, 'b').IsSome() {
+		t.Fail()
+	}
+	if iterator.NextIfEq(iter, 'a') != option.Some('a') {
+		t.Fail()
+	}
+	if iterator.NextIfEq(iter, 'b') != option.Some('b') {
+		t.Fail()
+	}
+	if iterator.NextIfEq(iter, 'b').IsSome() {
+		t.Fail()
+	}
+}
+
+func TestPeekable_LongSession(t *testing.T) {
+	iter := iterator.Peekable[int](iterator.Range(0, 1000))
+	for i := 0; i < 1000; i++ {
+		if i+3 < 1000 && iter.PeekN(3).Unwrap() != i+3 {
+			t.Fatalf("PeekN(3) at %v", i)
+		}
+		if iter.Next().Unwrap() != i {
+			t.Fatalf("Next at %v", i)
+		}
+	}
+	if iter.Next().IsSome() {
+		t.Fail()
+	}
+}
+
+func TestNextIfEq_Interface(t *testing.T) {
+	var iter iterator.PeekableIterator[int] = iterator.Peekable[int](iterator.Range(1, 3))
+	if iterator.NextIfEq(iter, 1) != option.Some(1) || iterator.NextIfEq(iter, 1).IsSome() {
+		t.Fail()
+	}
+}
@@ -0,0 +1,97 @@
+package iterator
+
+import (
+	"math"
+
+	"github.com/sidkurella/goption/option"
+)
+
+// An iterator over the k-element permutations of a slice, in lexicographic order of position.
+type permutationsIterator[T any] struct {
+	data    []T
+	indices []int // A permutation of the positions in data, whose first k entries form the next permutation.
+	cycles  []int // For each of the first k places, the number of choices left for it before it resets.
+	started bool
+	done    bool
+	count   countdown
+}
+
+// Permutations creates an iterator over the k-element permutations of data, in lexicographic order of position.
+// Each permutation is yielded as a freshly allocated slice.
+// Elements are treated as distinct by position, not value, so data with repeated values yields repeated permutations.
+// If k is greater than len(data), the iterator is empty; if k is 0, it yields one empty slice.
+// data is not copied, so it must not be modified while the iterator is in use.
+func Permutations[T any](data []T, k uint64) *permutationsIterator[T] {
+	n := uint64(len(data))
+	p := &permutationsIterator[T]{
+		data:    data,
+		indices: nil,
+		cycles:  nil,
+		started: false,
+		done:    k > n,
+		count:   countdown{left: 0, exact: true},
+	}
+	if p.done {
+		return p
+	}
+	p.count.left = 1
+	p.indices = make([]int, n)
+	for i := range p.indices {
+		p.indices[i] = i
+	}
+	p.cycles = make([]int, k)
+	for i := range p.cycles {
+		p.cycles[i] = len(data) - i
+		if p.count.exact && p.count.left > math.MaxUint64/(n-uint64(i)) {
+			p.count = countdown{left: math.MaxUint64, exact: false}
+		}
+		if p.count.exact {
+			p.count.left *= n - uint64(i)
+		}
+	}
+	return p
+}
+
+func (p *permutationsIterator[T]) Next() option.Option[[]T] {
+	if p.done {
+		return option.Nothing[[]T]()
+	}
+	if !p.started {
+		p.started = true
+	} else if !p.advance() {
+		p.done = true
+		p.count = countdown{left: 0, exact: true}
+		return option.Nothing[[]T]()
+	}
+	p.count.dec()
+	ret := make([]T, len(p.cycles))
+	for i := range ret {
+		ret[i] = p.data[p.indices[i]]
+	}
+	return option.Some(ret)
+}
+
+// advance moves indices to the next permutation. Returns false if there is none.
+func (p *permutationsIterator[T]) advance() bool {
+	n := len(p.indices)
+	for i := len(p.cycles) - 1; i >= 0; i-- {
+		p.cycles[i]--
+		if p.cycles[i] == 0 {
+			// Every choice for place i has been used: rotate it to the end and start over.
+			first := p.indices[i]
+			copy(p.indices[i:], p.indices[i+1:])
+			p.indices[n-1] = first
+			p.cycles[i] = n - i
+			continue
+		}
+		j := n - p.cycles[i]
+		p.indices[i], p.indices[j] = p.indices[j], p.indices[i]
+		return true
+	}
+	return false
+}
+
+// Returns the bounds on the remaining length, which is known exactly unless it does not fit in a uint64.
+func (p *permutationsIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	return p.count.hint()
+}
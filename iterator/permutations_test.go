@@ -0,0 +1,45 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+)
+
+func TestPermutations(t *testing.T) {
+	t.Run("all", func(t *testing.T) {
+		iter := iterator.Permutations([]int{1, 2, 3}, 3)
+		lower, upper := iter.SizeHint()
+		if lower != 6 || upper != option.Some[uint64](6) {
+			t.Fail()
+		}
+		expected := [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}
+		if !reflect.DeepEqual(collectCheckingHint[[]int](t, iter), expected) {
+			t.Fail()
+		}
+	})
+	t.Run("k of n", func(t *testing.T) {
+		iter := iterator.Permutations([]string{"a", "b", "c"}, 2)
+		expected := [][]string{{"a", "b"}, {"a", "c"}, {"b", "a"}, {"b", "c"}, {"c", "a"}, {"c", "b"}}
+		if !reflect.DeepEqual(collectCheckingHint[[]string](t, iter), expected) {
+			t.Fail()
+		}
+	})
+	t.Run("count", func(t *testing.T) {
+		if iterator.Count[[]int](iterator.Permutations([]int{1, 2, 3, 4, 5}, 3)) != 60 {
+			t.Fail()
+		}
+	})
+	t.Run("k of 0", func(t *testing.T) {
+		if !reflect.DeepEqual(iterator.Collect[[]int](iterator.Permutations([]int{}, 0)), [][]int{{}}) {
+			t.Fail()
+		}
+	})
+	t.Run("k greater than n", func(t *testing.T) {
+		if iterator.Permutations([]int{1}, 2).Next().IsSome() {
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package iterator
+
+import (
+	"math"
+
+	"github.com/sidkurella/goption/option"
+)
+
+// An iterator over the subsets of a slice, in order of increasing size.
+type powersetIterator[T any] struct {
+	data  []T
+	k     uint64                   // The size of the subsets currently being yielded.
+	combs *combinationsIterator[T] // The subsets of size k.
+	count countdown
+}
+
+// Powerset creates an iterator over every subset of data: first the empty slice, then each single element, then
+// each pair, and so on up to data itself. Subsets of the same size are in the order of Combinations.
+// Each subset is yielded as a freshly allocated slice.
+// data is not copied, so it must not be modified while the iterator is in use.
+func Powerset[T any](data []T) *powersetIterator[T] {
+	count := countdown{left: math.MaxUint64, exact: false}
+	if len(data) < 64 {
+		count = countdown{left: 1 << len(data), exact: true}
+	}
+	return &powersetIterator[T]{
+		data:  data,
+		k:     0,
+		combs: Combinations(data, 0),
+		count: count,
+	}
+}
+
+func (p *powersetIterator[T]) Next() option.Option[[]T] {
+	for p.k <= uint64(len(p.data)) {
+		if ret := p.combs.Next(); ret.IsSome() {
+			p.count.dec()
+			return ret
+		}
+		p.k++
+		p.combs = Combinations(p.data, p.k)
+	}
+	return option.Nothing[[]T]()
+}
+
+// Returns the bounds on the remaining length, which is known exactly unless it does not fit in a uint64.
+func (p *powersetIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	return p.count.hint()
+}
@@ -0,0 +1,28 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+)
+
+func TestPowerset(t *testing.T) {
+	t.Run("three elements", func(t *testing.T) {
+		iter := iterator.Powerset([]int{1, 2, 3})
+		lower, upper := iter.SizeHint()
+		if lower != 8 || upper != option.Some[uint64](8) {
+			t.Fail()
+		}
+		expected := [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}
+		if !reflect.DeepEqual(collectCheckingHint[[]int](t, iter), expected) {
+			t.Fail()
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		if !reflect.DeepEqual(iterator.Collect[[]int](iterator.Powerset([]int{})), [][]int{{}}) {
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package iterator
+
+import (
+	"math"
+
+	"github.com/sidkurella/goption/option"
+)
+
+// An iterator over the cartesian product of several iterators.
+type productIterator[T any] struct {
+	iters   []Iterator[T]
+	pools   [][]T // The buffered elements of every iterator but the first.
+	head    T     // The current element of the first iterator.
+	idx     []int // The positions in pools of the elements of the current tuple.
+	started bool
+	done    bool
+}
+
+// CartesianProduct creates an iterator over every tuple that takes one element from each of iters, in
+// lexicographic order: the last iterator varies fastest. Each tuple is yielded as a freshly allocated slice.
+// If any iterator is empty, so is the product; if there are no iterators, it yields one empty slice.
+//
+// Every iterator but the first is buffered on the first call to Next, since its elements are needed repeatedly;
+// they must therefore be finite. The first iterator is consumed lazily, and may be infinite.
+func CartesianProduct[T any](iters ...Iterator[T]) *productIterator[T] {
+	return &productIterator[T]{
+		iters:   iters,
+		pools:   nil,
+		idx:     nil,
+		started: false,
+		done:    false,
+	}
+}
+
+func (p *productIterator[T]) Next() option.Option[[]T] {
+	if p.done {
+		return option.Nothing[[]T]()
+	}
+	if !p.started {
+		p.start()
+	} else {
+		p.advance()
+	}
+	if p.done {
+		return option.Nothing[[]T]()
+	}
+	ret := make([]T, len(p.iters))
+	if len(ret) > 0 {
+		ret[0] = p.head
+	}
+	for i, j := range p.idx {
+		ret[i+1] = p.pools[i][j]
+	}
+	return option.Some(ret)
+}
+
+// start buffers the pools and takes the first element of the first iterator.
+func (p *productIterator[T]) start() {
+	p.started = true
+	if len(p.iters) == 0 {
+		return
+	}
+	p.pools = make([][]T, len(p.iters)-1)
+	for i, iter := range p.iters[1:] {
+		p.pools[i] = Collect(iter)
+		if len(p.pools[i]) == 0 {
+			p.done = true
+			return
+		}
+	}
+	p.idx = make([]int, len(p.pools))
+	p.nextHead()
+}
+
+// advance moves to the next tuple, taking the next element of the first iterator once the pools wrap around.
+func (p *productIterator[T]) advance() {
+	for i := len(p.idx) - 1; i >= 0; i-- {
+		p.idx[i]++
+		if p.idx[i] < len(p.pools[i]) {
+			return
+		}
+		p.idx[i] = 0
+	}
+	if len(p.iters) == 0 {
+		p.done = true
+		return
+	}
+	p.nextHead()
+}
+
+// nextHead takes the next element of the first iterator, finishing the product if there is none.
+func (p *productIterator[T]) nextHead() {
+	val, ok := p.iters[0].Next().Get()
+	if !ok {
+		p.done = true
+		return
+	}
+	p.head = val
+}
+
+// Returns the bounds on the remaining length.
+// Before the first call to Next, these are the products of the iterators' bounds.
+func (p *productIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	switch {
+	case p.done:
+		return exactSizeHint(0)
+	case len(p.iters) == 0:
+		if p.started {
+			return exactSizeHint(0)
+		}
+		return exactSizeHint(1)
+	case !p.started:
+		lower, upper := uint64(1), option.Some[uint64](1)
+		for _, iter := range p.iters {
+			l, u := SizeHint(iter)
+			lower, upper = saturatingMul(lower, l), mulUpper(upper, u)
+		}
+		return lower, upper
+	}
+	// Count the tuples left for the current head, then those for the heads still to come.
+	block, rank := uint64(1), uint64(0)
+	for i, pool := range p.pools {
+		n := uint64(len(pool))
+		if block > math.MaxUint64/n {
+			headLower, _ := SizeHint(p.iters[0])
+			if headLower > 0 {
+				return math.MaxUint64, option.Nothing[uint64]()
+			}
+			return 0, option.Nothing[uint64]()
+		}
+		block *= n
+		rank = rank*n + uint64(p.idx[i])
+	}
+	current := block - rank - 1
+	headLower, headUpper := SizeHint(p.iters[0])
+	lower := saturatingAdd(current, saturatingMul(headLower, block))
+	upper := addUpper(option.Some(current), mulUpper(headUpper, option.Some(block)))
+	return lower, upper
+}
+
+// Close closes all the iterators, for those that implement Closer.
+func (p *productIterator[T]) Close() {
+	for _, iter := range p.iters {
+		closeIter(iter)
+	}
+	p.pools = nil
+	p.done = true
+}
@@ -0,0 +1,49 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+func TestCartesianProduct(t *testing.T) {
+	t.Run("three iterators", func(t *testing.T) {
+		iter := iterator.CartesianProduct[int](
+			iterator.Range(0, 2),
+			sliceutil.Iter([]int{10, 20}),
+			iterator.Range(5, 7),
+		)
+		lower, upper := iter.SizeHint()
+		if lower != 8 || upper != option.Some[uint64](8) {
+			t.Fail()
+		}
+		expected := [][]int{
+			{0, 10, 5}, {0, 10, 6}, {0, 20, 5}, {0, 20, 6},
+			{1, 10, 5}, {1, 10, 6}, {1, 20, 5}, {1, 20, 6},
+		}
+		if !reflect.DeepEqual(collectCheckingHint[[]int](t, iter), expected) {
+			t.Fail()
+		}
+	})
+	t.Run("infinite first iterator", func(t *testing.T) {
+		iter := iterator.CartesianProduct[int](iterator.Repeat(1), iterator.Range(0, 2))
+		actual := iterator.Collect[[]int](iterator.Take[[]int](iter, 3))
+		if !reflect.DeepEqual(actual, [][]int{{1, 0}, {1, 1}, {1, 0}}) {
+			t.Fail()
+		}
+	})
+	t.Run("empty iterator", func(t *testing.T) {
+		iter := iterator.CartesianProduct[int](iterator.Range(0, 2), iterator.Empty[int]())
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("no iterators", func(t *testing.T) {
+		if !reflect.DeepEqual(iterator.Collect[[]int](iterator.CartesianProduct[int]()), [][]int{{}}) {
+			t.Fail()
+		}
+	})
+}
@@ -1,7 +1,11 @@
 package iterator
 
 import (
+	"errors"
+	"math"
+
 	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/result"
 	"golang.org/x/exp/constraints"
 )
 
@@ -9,79 +13,269 @@ type numeric interface {
 	constraints.Integer | constraints.Float
 }
 
+// ErrZeroStep is returned by TryRangeBy and TryRangeInclusiveBy when given a step of zero.
+var ErrZeroStep = errors.New("iterator: range step must be non-zero")
+
+// The remaining elements of a range are those at the indices from front to last, inclusive, unless it is empty.
+// Tracking the index of the last element rather than the count means that a range over every value of uint64,
+// which has 2^64 elements, is still represented exactly.
 type rangeIterator[T numeric] struct {
-	start      T
-	end        T
-	current    T
-	step       T
-	includeEnd bool
-	backwards  bool
+	start T      // The element at index 0.
+	step  T      // The distance between successive elements.
+	front uint64 // The index of the next element to return from the front.
+	last  uint64 // The index of the next element to return from the back.
+	empty bool   // Whether the range has no elements remaining.
+}
+
+func newRangeIterator[T numeric](start T, end T, step T, includeEnd bool) *rangeIterator[T] {
+	last, ok := rangeLast(start, end, step, includeEnd)
+	return &rangeIterator[T]{
+		start: start,
+		step:  step,
+		front: 0,
+		last:  last,
+		empty: !ok,
+	}
+}
+
+// rangeLast returns the index of the last element in the range from start to end, stepping by step.
+// Returns false if the range is empty. A zero step yields start indefinitely if the range is non-empty.
+func rangeLast[T numeric](start T, end T, step T, includeEnd bool) (uint64, bool) {
+	forwards := start < end || (includeEnd && start == end)
+	backwards := start > end || (includeEnd && start == end)
+	switch {
+	case step == 0 && forwards:
+		return math.MaxUint64, true
+	case (step > 0 && forwards) || (step < 0 && backwards):
+		if isFloat[T]() {
+			return floatLastStep(start, end, step, includeEnd), true
+		}
+		if step > 0 {
+			return lastStep(start, end, step, includeEnd), true
+		}
+		return lastStep(end, start, -step, includeEnd), true
+	default:
+		return 0, false
+	}
+}
+
+// lastStep returns the number of whole steps of size step from lo that stay within hi, where lo <= hi, step > 0,
+// and the range from lo to hi is non-empty. T must be an integer type.
+// Distances are computed in uint64, so that the difference between the bounds cannot overflow T.
+func lastStep[T numeric](lo T, hi T, step T, includeEnd bool) uint64 {
+	dist := uint64(hi) - uint64(lo)
+	ustep := uint64(step)
+	if step < 0 { // Only reachable when negating the minimum signed value overflowed.
+		ustep = -uint64(step)
+	}
+	if !includeEnd {
+		dist-- // lo < hi, so the range from lo to just before hi is non-empty.
+	}
+	return dist / ustep
+}
+
+// floatLastStep returns the index of the last element of a non-empty float range with a non-zero step.
+func floatLastStep[T numeric](start T, end T, step T, includeEnd bool) uint64 {
+	q := float64(end-start) / float64(step)
+	n := math.Floor(q)
+	if !includeEnd && n == q {
+		n = max(n-1, 0) // The range is non-empty, so index 0 is always valid.
+	}
+	if n >= math.MaxUint64 || math.IsNaN(n) {
+		return math.MaxUint64
+	}
+	// The division may round differently from the elements themselves, which are computed as start + i*step, but
+	// by at most one step. Correct against the elements, so that the last one lies within end and the next does not.
+	i := uint64(n)
+	if i > 0 && !withinEnd(start, end, step, i, includeEnd) {
+		i--
+	} else if i < math.MaxUint64 && withinEnd(start, end, step, i+1, includeEnd) && start+T(i+1)*step != start+T(i)*step {
+		i++
+	}
+	return i
+}
+
+// withinEnd returns whether the element at index i of the range lies within end, in the direction of step.
+func withinEnd[T numeric](start T, end T, step T, i uint64, includeEnd bool) bool {
+	x := start + T(i)*step
+	if step < 0 {
+		return x > end || (includeEnd && x == end)
+	}
+	return x < end || (includeEnd && x == end)
+}
+
+// isFloat returns whether T is a floating-point type.
+// Only a float type can represent a half, which also covers types defined with float underlying types.
+func isFloat[T numeric]() bool {
+	return T(1)/T(2) != 0
+}
+
+// at returns the element at index i of the range.
+// It is computed as start + i*step rather than by repeated addition, so that float ranges do not accumulate
+// rounding error. For integers, the arithmetic wraps, but the result is in range for every valid index.
+func (r *rangeIterator[T]) at(i uint64) T {
+	return r.start + T(i)*r.step
 }
 
 func (r *rangeIterator[T]) Next() option.Option[T] {
-	ret := option.Nothing[T]()
-	if (!r.backwards && r.current < r.end) ||
-		(r.backwards && r.current > r.end) ||
-		(r.current == r.end && r.includeEnd) {
-		ret = option.Some(r.current)
-		r.current += r.step
+	if r.empty {
+		return option.Nothing[T]()
+	}
+	ret := option.Some(r.at(r.front))
+	if r.front == r.last {
+		r.empty = true
+	} else {
+		r.front++
 	}
+	return ret
+}
 
+// Returns the next element from the back of the range.
+func (r *rangeIterator[T]) NextBack() option.Option[T] {
+	if r.empty {
+		return option.Nothing[T]()
+	}
+	ret := option.Some(r.at(r.last))
+	if r.front == r.last {
+		r.empty = true
+	} else {
+		r.last--
+	}
 	return ret
 }
 
+// Returns the number of elements remaining in the range.
+// A range with more than math.MaxUint64 elements remaining, such as one with a zero step that yields elements
+// indefinitely, reports math.MaxUint64.
+func (r *rangeIterator[T]) Len() uint64 {
+	if r.empty {
+		return 0
+	}
+	return saturatingAdd(r.last-r.front, 1)
+}
+
+// Returns the bounds on the remaining length of the range.
+// A range with more than math.MaxUint64 elements remaining, such as one with a zero step that yields elements
+// indefinitely, has no upper bound.
+func (r *rangeIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	if !r.empty && (r.step == 0 || r.last-r.front == math.MaxUint64) {
+		return math.MaxUint64, option.Nothing[uint64]()
+	}
+	return exactSizeHint(r.Len())
+}
+
+// Nth returns the nth remaining element of the range, counting from 0, in constant time.
+// As with the Nth function, the elements before it are consumed, and the range is exhausted if it has n or fewer
+// elements remaining.
+func (r *rangeIterator[T]) Nth(n uint64) option.Option[T] {
+	if r.empty || n > r.last-r.front {
+		r.empty = true
+		return option.Nothing[T]()
+	}
+	r.front += n
+	return r.Next()
+}
+
+// Contains returns whether x is one of the elements remaining in the range, in constant time.
+func (r *rangeIterator[T]) Contains(x T) bool {
+	if r.empty {
+		return false
+	}
+	first, last := r.at(r.front), r.at(r.last)
+	lo, hi := min(first, last), max(first, last)
+	if !(x >= lo && x <= hi) { // Also rejects NaN.
+		return false
+	}
+	if r.step == 0 || first == last {
+		return x == first
+	}
+	if isFloat[T]() {
+		i := math.Round(float64(x-r.start) / float64(r.step))
+		if i < float64(r.front) || i > float64(r.last) {
+			return false
+		}
+		return r.at(uint64(i)) == x
+	}
+	// Distances are measured from first in uint64, so that they cannot overflow T. The size of a step is taken from
+	// two adjacent elements, since the step itself may have wrapped, as in a reversed unsigned range.
+	next := r.at(r.front + 1)
+	dist, ustep := uint64(x)-uint64(first), uint64(next)-uint64(first)
+	if first > last {
+		dist, ustep = uint64(first)-uint64(x), uint64(first)-uint64(next)
+	}
+	return dist%ustep == 0
+}
+
+// Reverse returns a range over the remaining elements in reverse order.
+// Unlike Rev, the result is itself a range, so Contains, Nth and Reverse remain available.
+func (r *rangeIterator[T]) Reverse() *rangeIterator[T] {
+	if r.empty {
+		return &rangeIterator[T]{
+			start: r.start,
+			step:  -r.step,
+			front: 0,
+			last:  0,
+			empty: true,
+		}
+	}
+	return &rangeIterator[T]{
+		start: r.at(r.last),
+		step:  -r.step, // For unsigned types, this wraps, and adding it wraps back: the elements are still exact.
+		front: 0,
+		last:  r.last - r.front,
+		empty: false,
+	}
+}
+
+// Returns an independent copy of the range at its current position.
+func (r *rangeIterator[T]) Clone() Iterator[T] {
+	c := *r
+	return &c
+}
+
 // Returns an iterator ranging from start (inclusive) to end (exclusive), stepping by 1.
 // If end is less than start, the iterator will be empty.
 func Range[T numeric](start T, end T) *rangeIterator[T] {
-	return &rangeIterator[T]{
-		start:      start,
-		end:        end,
-		current:    start,
-		step:       T(1),
-		includeEnd: false,
-		backwards:  false,
-	}
+	return newRangeIterator(start, end, T(1), false)
 }
 
 // Returns an iterator ranging from start (inclusive) to end (exclusive), stepping by step.
 // If end is less than start, the iterator will be empty.
 // This can be used with a negative step. If so, if end is greater than start, the iterator will be empty.
-// NOTE: A zero step will return start ad infinitum.
+// The ith element is computed as start + i*step, so float ranges do not accumulate rounding error.
+// NOTE: A zero step will return start ad infinitum. Use TryRangeBy to reject zero steps instead.
 func RangeBy[T numeric](start T, end T, step T) *rangeIterator[T] {
-	return &rangeIterator[T]{
-		start:      start,
-		end:        end,
-		current:    start,
-		step:       step,
-		includeEnd: false,
-		backwards:  step < 0,
+	return newRangeIterator(start, end, step, false)
+}
+
+// TryRangeBy is like RangeBy, but returns ErrZeroStep if step is zero, rather than an infinite range.
+func TryRangeBy[T numeric](start T, end T, step T) result.Result[*rangeIterator[T], error] {
+	if step == 0 {
+		return result.Err[*rangeIterator[T]](ErrZeroStep)
 	}
+	return result.Ok[*rangeIterator[T], error](RangeBy(start, end, step))
 }
 
 // Returns an iterator ranging from start (inclusive) to end (inclusive), stepping by 1.
 // If end is less than start, the iterator will be empty.
+// The range may include the maximum value of T: RangeInclusive(0, uint8(255)) yields all 256 values.
 func RangeInclusive[T numeric](start T, end T) *rangeIterator[T] {
-	return &rangeIterator[T]{
-		start:      start,
-		end:        end,
-		current:    start,
-		step:       T(1),
-		includeEnd: true,
-		backwards:  false,
-	}
+	return newRangeIterator(start, end, T(1), true)
 }
 
-// Returns an iterator ranging from start (inclusive) to end (exclusive), stepping by step.
+// Returns an iterator ranging from start (inclusive) to end (inclusive), stepping by step.
 // If end is less than start, the iterator will be empty.
 // This can be used with a negative step. If so, if end is greater than start, the iterator will be empty.
-// NOTE: A zero step will return start ad infinitum.
+// NOTE: A zero step will return start ad infinitum. Use TryRangeInclusiveBy to reject zero steps instead.
 func RangeInclusiveBy[T numeric](start T, end T, step T) *rangeIterator[T] {
-	return &rangeIterator[T]{
-		start:      start,
-		end:        end,
-		current:    start,
-		step:       step,
-		includeEnd: true,
-		backwards:  step < 0,
+	return newRangeIterator(start, end, step, true)
+}
+
+// TryRangeInclusiveBy is like RangeInclusiveBy, but returns ErrZeroStep if step is zero, rather than an infinite
+// range.
+func TryRangeInclusiveBy[T numeric](start T, end T, step T) result.Result[*rangeIterator[T], error] {
+	if step == 0 {
+		return result.Err[*rangeIterator[T]](ErrZeroStep)
 	}
+	return result.Ok[*rangeIterator[T], error](RangeInclusiveBy(start, end, step))
 }
@@ -0,0 +1,65 @@
+package iterator
+
+import "github.com/sidkurella/goption/option"
+
+// An iterator over a range of any type, stepping with a successor function.
+type rangeFuncIterator[T any] struct {
+	next       T
+	end        T
+	succ       func(T) T
+	compare    func(T, T) int
+	includeEnd bool
+	done       bool
+}
+
+// RangeFunc returns an iterator ranging from start (inclusive) to end (exclusive), where each element after the
+// first is succ applied to the one before it. Elements are yielded while they compare less than end.
+// compare(a, b) should return a negative number if a < b, zero if a == b, and a positive number if a > b.
+// succ must eventually reach or pass end, or the iterator is infinite.
+func RangeFunc[T any](start T, end T, compare func(T, T) int, succ func(T) T) *rangeFuncIterator[T] {
+	return newRangeFuncIterator(start, end, compare, succ, false)
+}
+
+// RangeInclusiveFunc returns an iterator ranging from start (inclusive) to end (inclusive), where each element
+// after the first is succ applied to the one before it. See RangeFunc.
+func RangeInclusiveFunc[T any](start T, end T, compare func(T, T) int, succ func(T) T) *rangeFuncIterator[T] {
+	return newRangeFuncIterator(start, end, compare, succ, true)
+}
+
+func newRangeFuncIterator[T any](
+	start T, end T, compare func(T, T) int, succ func(T) T, includeEnd bool,
+) *rangeFuncIterator[T] {
+	return &rangeFuncIterator[T]{
+		next:       start,
+		end:        end,
+		succ:       succ,
+		compare:    compare,
+		includeEnd: includeEnd,
+		done:       false,
+	}
+}
+
+func (r *rangeFuncIterator[T]) Next() option.Option[T] {
+	if r.done {
+		return option.Nothing[T]()
+	}
+	c := r.compare(r.next, r.end)
+	if c > 0 || (c == 0 && !r.includeEnd) {
+		r.done = true
+		return option.Nothing[T]()
+	}
+	ret := r.next
+	if c == 0 {
+		// Stop at end without calling succ past it, which may not be defined.
+		r.done = true
+	} else {
+		r.next = r.succ(r.next)
+	}
+	return option.Some(ret)
+}
+
+// Returns an independent copy of the range at its current position.
+func (r *rangeFuncIterator[T]) Clone() Iterator[T] {
+	c := *r
+	return &c
+}
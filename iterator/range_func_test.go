@@ -0,0 +1,40 @@
+package iterator_test
+
+import (
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+func TestRangeFunc(t *testing.T) {
+	t.Run("strings", func(t *testing.T) {
+		iter := iterator.RangeFunc("a", "aaaa", strings.Compare, func(s string) string { return s + "a" })
+		if !reflect.DeepEqual(iterator.Collect[string](iter), []string{"a", "aa", "aaa"}) {
+			t.Fail()
+		}
+	})
+	t.Run("inclusive", func(t *testing.T) {
+		iter := iterator.RangeInclusiveFunc(big.NewInt(1), big.NewInt(8), (*big.Int).Cmp, func(x *big.Int) *big.Int {
+			return new(big.Int).Lsh(x, 1)
+		})
+		actual := iterator.Collect(iterator.Map[*big.Int](iter, (*big.Int).Int64))
+		if !reflect.DeepEqual(actual, []int64{1, 2, 4, 8}) {
+			t.Fail()
+		}
+	})
+	t.Run("passes end", func(t *testing.T) {
+		iter := iterator.RangeInclusiveFunc(1, 10, func(a int, b int) int { return a - b }, func(x int) int { return x * 3 })
+		if !reflect.DeepEqual(iterator.Collect[int](iter), []int{1, 3, 9}) {
+			t.Fail()
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		iter := iterator.RangeFunc(5, 5, func(a int, b int) int { return a - b }, func(x int) int { return x + 1 })
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+}
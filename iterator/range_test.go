@@ -1,6 +1,8 @@
 package iterator_test
 
 import (
+	"errors"
+	"math"
 	"reflect"
 	"testing"
 
@@ -98,3 +100,278 @@ func TestRangeInclusiveBy(t *testing.T) {
 		}
 	})
 }
+
+func TestRange_NextBack(t *testing.T) {
+	t.Run("range", func(t *testing.T) {
+		i := iterator.Rev[int](iterator.RangeBy(1, 8, 3))
+		expected := []int{7, 4, 1}
+		actual := iterator.Collect[int](i)
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fail()
+		}
+	})
+	t.Run("range inclusive backwards", func(t *testing.T) {
+		i := iterator.Rev[int](iterator.RangeInclusiveBy(6, 1, -2))
+		expected := []int{2, 4, 6}
+		actual := iterator.Collect[int](i)
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fail()
+		}
+	})
+	t.Run("both ends meet", func(t *testing.T) {
+		i := iterator.Range(0, 3)
+		if i.Next().Unwrap() != 0 || i.NextBack().Unwrap() != 2 || i.Next().Unwrap() != 1 {
+			t.Fail()
+		}
+		if i.Next().IsSome() || i.NextBack().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("full width", func(t *testing.T) {
+		i := iterator.Rev[int8](iterator.RangeInclusiveBy[int8](127, -128, -1))
+		if iterator.Count[int8](i) != 256 {
+			t.Fail()
+		}
+	})
+}
+
+func TestRange_Clone(t *testing.T) {
+	r := iterator.RangeBy(0, 10, 3)
+	r.Next()
+	clone := r.Clone()
+	if !reflect.DeepEqual(iterator.Collect[int](r), []int{3, 6, 9}) {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(iterator.Collect(clone), []int{3, 6, 9}) {
+		t.Fail()
+	}
+}
+
+func TestRangeBy_FloatAccuracy(t *testing.T) {
+	actual := iterator.Collect[float64](iterator.RangeBy(0.0, 1.0, 0.1))
+	if len(actual) != 10 {
+		t.Fatalf("expected 10 elements, got %v", actual)
+	}
+	for i, x := range actual {
+		if x != float64(i)*0.1 {
+			t.Errorf("element %v: expected %v, got %v", i, float64(i)*0.1, x)
+		}
+	}
+	back := iterator.RangeBy(0.0, 1.0, 0.1).NextBack().Unwrap()
+	if back != 9*0.1 {
+		t.Fail()
+	}
+}
+
+func TestRangeBy_FloatBackwards(t *testing.T) {
+	t.Run("exclusive", func(t *testing.T) {
+		actual := iterator.Collect[float64](iterator.RangeBy(0.5, 0.1, -0.1))
+		if len(actual) != 4 || actual[3] <= 0.1 {
+			t.Fatalf("got %v", actual)
+		}
+	})
+	t.Run("inclusive", func(t *testing.T) {
+		for _, start := range []float64{0.4, 0.5} {
+			actual := iterator.Collect[float64](iterator.RangeInclusiveBy(start, 0.1, -0.1))
+			for _, x := range actual {
+				if x < 0.1 {
+					t.Fatalf("from %v: got %v", start, actual)
+				}
+			}
+		}
+	})
+	t.Run("back", func(t *testing.T) {
+		if x := iterator.RangeInclusiveBy(0.5, 0.1, -0.1).NextBack().Unwrap(); x < 0.1 {
+			t.Fatalf("got %v", x)
+		}
+	})
+}
+
+func TestRange_Overflow(t *testing.T) {
+	t.Run("full uint8", func(t *testing.T) {
+		actual := iterator.Collect[uint8](iterator.RangeInclusive[uint8](0, 255))
+		if len(actual) != 256 || actual[0] != 0 || actual[255] != 255 {
+			t.Fail()
+		}
+	})
+	t.Run("full int8", func(t *testing.T) {
+		actual := iterator.Collect[int8](iterator.RangeInclusive[int8](-128, 127))
+		if len(actual) != 256 || actual[0] != -128 || actual[255] != 127 {
+			t.Fail()
+		}
+	})
+	t.Run("step past max", func(t *testing.T) {
+		actual := iterator.Collect[uint8](iterator.RangeInclusiveBy[uint8](200, 255, 50))
+		if !reflect.DeepEqual(actual, []uint8{200, 250}) {
+			t.Fatalf("got %v", actual)
+		}
+	})
+	t.Run("full uint64", func(t *testing.T) {
+		r := iterator.RangeInclusive[uint64](0, math.MaxUint64)
+		lower, upper := r.SizeHint()
+		if lower != math.MaxUint64 || upper.IsSome() {
+			t.Fail()
+		}
+		if r.Len() != math.MaxUint64 {
+			t.Fail()
+		}
+		if r.NextBack().Unwrap() != math.MaxUint64 {
+			t.Fail()
+		}
+		lower, upper = r.SizeHint()
+		if lower != math.MaxUint64 || upper.Unwrap() != math.MaxUint64 {
+			t.Fail()
+		}
+		if r.Nth(math.MaxUint64-1).Unwrap() != math.MaxUint64-1 {
+			t.Fail()
+		}
+		if r.Next().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("int64 extremes", func(t *testing.T) {
+		r := iterator.RangeBy[int64](math.MinInt64, math.MaxInt64, math.MaxInt64)
+		actual := iterator.Collect[int64](r)
+		if !reflect.DeepEqual(actual, []int64{math.MinInt64, -1, math.MaxInt64 - 1}) {
+			t.Fatalf("got %v", actual)
+		}
+	})
+}
+
+func TestTryRangeBy(t *testing.T) {
+	if err := iterator.TryRangeBy(0, 10, 0).UnwrapErr(); !errors.Is(err, iterator.ErrZeroStep) {
+		t.Fail()
+	}
+	if err := iterator.TryRangeInclusiveBy(0, 10, 0).UnwrapErr(); !errors.Is(err, iterator.ErrZeroStep) {
+		t.Fail()
+	}
+	r := iterator.TryRangeBy(0, 10, 4).Unwrap()
+	if !reflect.DeepEqual(iterator.Collect[int](r), []int{0, 4, 8}) {
+		t.Fail()
+	}
+	r = iterator.TryRangeInclusiveBy(10, 0, -5).Unwrap()
+	if !reflect.DeepEqual(iterator.Collect[int](r), []int{10, 5, 0}) {
+		t.Fail()
+	}
+}
+
+func TestRange_Len(t *testing.T) {
+	r := iterator.RangeBy(0, 10, 3)
+	if r.Len() != 4 {
+		t.Fail()
+	}
+	r.Next()
+	r.NextBack()
+	if r.Len() != 2 {
+		t.Fail()
+	}
+	if iterator.Range(5, 0).Len() != 0 {
+		t.Fail()
+	}
+	if iterator.RangeBy(0, 1, 0).Len() != math.MaxUint64 {
+		t.Fail()
+	}
+}
+
+func TestRange_Contains(t *testing.T) {
+	t.Run("ascending", func(t *testing.T) {
+		r := iterator.RangeBy(1, 10, 3) // 1, 4, 7
+		for x, expected := range map[int]bool{0: false, 1: true, 2: false, 4: true, 7: true, 10: false} {
+			if r.Contains(x) != expected {
+				t.Errorf("Contains(%v): expected %v", x, expected)
+			}
+		}
+	})
+	t.Run("descending", func(t *testing.T) {
+		r := iterator.RangeInclusiveBy(10, -2, -4) // 10, 6, 2, -2
+		for x, expected := range map[int]bool{10: true, 8: false, -2: true, -6: false, 14: false} {
+			if r.Contains(x) != expected {
+				t.Errorf("Contains(%v): expected %v", x, expected)
+			}
+		}
+	})
+	t.Run("float", func(t *testing.T) {
+		step := 0.1
+		r := iterator.RangeBy(0.0, 1.0, step)
+		if !r.Contains(3*step) || r.Contains(0.35) || r.Contains(1.0) || r.Contains(math.NaN()) {
+			t.Fail()
+		}
+	})
+	t.Run("after consumption", func(t *testing.T) {
+		r := iterator.Range(0, 5)
+		r.Next()
+		r.NextBack()
+		if r.Contains(0) || !r.Contains(1) || !r.Contains(3) || r.Contains(4) {
+			t.Fail()
+		}
+		iterator.Count[int](r)
+		if r.Contains(2) {
+			t.Fail()
+		}
+	})
+	t.Run("reversed minimum step", func(t *testing.T) {
+		r := iterator.RangeInclusiveBy[int8](127, -128, -128).Reverse() // -1, 127
+		if !r.Contains(-1) || !r.Contains(127) || r.Contains(0) {
+			t.Fail()
+		}
+	})
+	t.Run("defined float type", func(t *testing.T) {
+		type celsius float64
+		r := iterator.RangeBy[celsius](0, 1, 0.25)
+		if !r.Contains(0.5) || r.Contains(0.6) {
+			t.Fail()
+		}
+	})
+	t.Run("zero step", func(t *testing.T) {
+		r := iterator.RangeBy(3, 5, 0)
+		if !r.Contains(3) || r.Contains(4) {
+			t.Fail()
+		}
+	})
+}
+
+func TestRange_Nth(t *testing.T) {
+	r := iterator.RangeBy(0, 100, 10)
+	if r.Nth(2).Unwrap() != 20 {
+		t.Fail()
+	}
+	if iterator.Nth[int](r, 0).Unwrap() != 30 {
+		t.Fail()
+	}
+	if r.Nth(6).IsSome() {
+		t.Fail()
+	}
+	if r.Next().IsSome() {
+		t.Fail()
+	}
+	big := iterator.Range[uint64](0, math.MaxUint64)
+	if iterator.Nth[uint64](big, 1<<62).Unwrap() != 1<<62 {
+		t.Fail()
+	}
+}
+
+func TestRange_Reverse(t *testing.T) {
+	t.Run("signed", func(t *testing.T) {
+		r := iterator.RangeBy(0, 10, 3)
+		r.Next()
+		actual := iterator.Collect[int](r.Reverse())
+		if !reflect.DeepEqual(actual, []int{9, 6, 3}) {
+			t.Fatalf("got %v", actual)
+		}
+	})
+	t.Run("unsigned", func(t *testing.T) {
+		r := iterator.RangeInclusive[uint8](250, 255).Reverse()
+		if !r.Contains(252) || r.Contains(249) || r.Contains(0) {
+			t.Fail()
+		}
+		actual := iterator.Collect[uint8](r)
+		if !reflect.DeepEqual(actual, []uint8{255, 254, 253, 252, 251, 250}) {
+			t.Fatalf("got %v", actual)
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		if iterator.Range(3, 3).Reverse().Next().IsSome() {
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package iterator
+
+import (
+	"math"
+
+	"github.com/sidkurella/goption/option"
+)
+
+type repeatIterator[T any] struct {
+	t T
+}
+
+// Creates an iterator that yields t endlessly.
+// The item will only be shallow-copied. If you desire deep copying/bespoke behavior, use RepeatWith.
+// Combine with Take or TakeWhile to bound the iterator.
+// Every element is t, so the iterator is double-ended: NextBack, and therefore Last, also return t.
+func Repeat[T any](t T) *repeatIterator[T] {
+	return &repeatIterator[T]{
+		t: t,
+	}
+}
+
+// Creates an iterator that yields t exactly n times.
+func RepeatN[T any](t T, n uint64) *takeIterator[T] {
+	return Take[T](Repeat(t), n)
+}
+
+func (r *repeatIterator[T]) Next() option.Option[T] {
+	return option.Some(r.t)
+}
+
+func (r *repeatIterator[T]) NextBack() option.Option[T] {
+	return option.Some(r.t)
+}
+
+// Returns the bounds on the remaining length. The iterator is endless, so there is no upper bound.
+func (r *repeatIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	return math.MaxUint64, option.Nothing[uint64]()
+}
+
+type repeatWithIterator[T any] struct {
+	f func() T
+}
+
+// Creates an iterator that yields the result of calling f endlessly.
+// Combine with Take or TakeWhile to bound the iterator.
+// The elements are only defined in the order f is called, so the iterator is not double-ended, and functions that
+// consume it to the end, such as Last, never return.
+func RepeatWith[T any](f func() T) *repeatWithIterator[T] {
+	return &repeatWithIterator[T]{
+		f: f,
+	}
+}
+
+func (r *repeatWithIterator[T]) Next() option.Option[T] {
+	return option.Some(r.f())
+}
+
+// Returns the bounds on the remaining length. The iterator is endless, so there is no upper bound.
+func (r *repeatWithIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	return math.MaxUint64, option.Nothing[uint64]()
+}
@@ -0,0 +1,51 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+func TestRepeat(t *testing.T) {
+	iter := iterator.Take[string](iterator.Repeat("a"), 3)
+	expected := []string{"a", "a", "a"}
+	actual := iterator.Collect[string](iter)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+}
+
+func TestRepeat_NextBack(t *testing.T) {
+	if iterator.Last[string](iterator.Repeat("a")).Unwrap() != "a" {
+		t.Fail()
+	}
+	var with iterator.Iterator[int] = iterator.RepeatWith(func() int { return 0 })
+	if _, ok := with.(iterator.DoubleEndedIterator[int]); ok {
+		t.Error("RepeatWith should not be double-ended")
+	}
+}
+
+func TestRepeatN(t *testing.T) {
+	iter := iterator.RepeatN(7, 2)
+	expected := []int{7, 7}
+	actual := iterator.Collect[int](iter)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+}
+
+func TestRepeatWith(t *testing.T) {
+	i := 0
+	iter := iterator.RepeatWith(func() int {
+		i++
+		return i * i
+	})
+	expected := []int{1, 4, 9, 16}
+	actual := iterator.Collect[int](iterator.TakeWhile[int](iter, func(v int) bool {
+		return v < 20
+	}))
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+}
@@ -0,0 +1,66 @@
+package iterator
+
+import "math/rand/v2"
+
+// Reservoir is a uniform random sample of bounded size from a stream of elements, maintained by reservoir
+// sampling. After any number of elements have been added, each of them is in the sample with equal probability.
+type Reservoir[T any] struct {
+	k      uint64
+	seen   uint64
+	sample []T
+	rng    *rand.Rand
+}
+
+// NewReservoir returns an empty reservoir that keeps a sample of at most k elements.
+// Random numbers are drawn from rng, which allows sampling to be made deterministic, for instance in tests.
+// If rng is nil, the top-level functions of math/rand/v2 are used instead.
+func NewReservoir[T any](k uint64, rng *rand.Rand) *Reservoir[T] {
+	return &Reservoir[T]{
+		k:      k,
+		seen:   0,
+		sample: nil,
+		rng:    rng,
+	}
+}
+
+// Sample consumes the iterator, returning a uniform random sample of k of its elements in O(k) memory.
+// If the iterator has k or fewer elements, all of them are returned in their original order; otherwise, the order
+// of the sample is unspecified. See NewReservoir for the meaning of rng.
+func Sample[T any](iter Iterator[T], k uint64, rng *rand.Rand) []T {
+	r := NewReservoir[T](k, rng)
+	ForEach(iter, r.Add)
+	return r.Sample()
+}
+
+// Add offers an element to the reservoir, which keeps it with probability k/n, where n is the number of elements
+// added so far, replacing a uniformly chosen element of the sample.
+func (r *Reservoir[T]) Add(t T) {
+	r.seen++
+	if uint64(len(r.sample)) < r.k {
+		r.sample = append(r.sample, t)
+		return
+	}
+	if j := r.uint64N(r.seen); j < r.k {
+		r.sample[j] = t
+	}
+}
+
+// uint64N returns a uniform random number in [0, n).
+func (r *Reservoir[T]) uint64N(n uint64) uint64 {
+	if r.rng == nil {
+		return rand.Uint64N(n)
+	}
+	return r.rng.Uint64N(n)
+}
+
+// Seen returns the number of elements added to the reservoir.
+func (r *Reservoir[T]) Seen() uint64 {
+	return r.seen
+}
+
+// Sample returns a copy of the current sample.
+func (r *Reservoir[T]) Sample() []T {
+	ret := make([]T, len(r.sample))
+	copy(ret, r.sample)
+	return ret
+}
@@ -0,0 +1,66 @@
+package iterator_test
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+func TestSample(t *testing.T) {
+	t.Run("fewer than k", func(t *testing.T) {
+		actual := iterator.Sample[int](iterator.Range(0, 3), 5, rand.New(rand.NewPCG(1, 2)))
+		if !reflect.DeepEqual(actual, []int{0, 1, 2}) {
+			t.Fail()
+		}
+	})
+	t.Run("deterministic with seeded source", func(t *testing.T) {
+		a := iterator.Sample[int](iterator.Range(0, 1000), 10, rand.New(rand.NewPCG(1, 2)))
+		b := iterator.Sample[int](iterator.Range(0, 1000), 10, rand.New(rand.NewPCG(1, 2)))
+		if len(a) != 10 || !reflect.DeepEqual(a, b) {
+			t.Fail()
+		}
+		slices.Sort(a)
+		if len(slices.Compact(a)) != 10 {
+			t.Error("expected distinct elements")
+		}
+	})
+	t.Run("uniform", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(3, 4))
+		const trials = 20000
+		counts := make([]int, 10)
+		for range trials {
+			for _, x := range iterator.Sample[int](iterator.Range(0, 10), 3, rng) {
+				counts[x]++
+			}
+		}
+		// Each element is expected in 3/10 of the samples.
+		for x, c := range counts {
+			if c < trials*3/10*9/10 || c > trials*3/10*11/10 {
+				t.Errorf("element %v sampled %v times out of %v", x, c, trials)
+			}
+		}
+	})
+	t.Run("nil source", func(t *testing.T) {
+		if len(iterator.Sample[int](iterator.Range(0, 100), 7, nil)) != 7 {
+			t.Fail()
+		}
+	})
+}
+
+func TestReservoir(t *testing.T) {
+	r := iterator.NewReservoir[int](2, rand.New(rand.NewPCG(5, 6)))
+	r.Add(1)
+	r.Add(2)
+	sample := r.Sample()
+	sample[0] = 100
+	if !reflect.DeepEqual(r.Sample(), []int{1, 2}) {
+		t.Error("expected Sample to return a copy")
+	}
+	r.Add(3)
+	if r.Seen() != 3 || len(r.Sample()) != 2 {
+		t.Fail()
+	}
+}
@@ -0,0 +1,32 @@
+package iterator
+
+import "github.com/sidkurella/goption/option"
+
+type revIterator[T any] struct {
+	inner DoubleEndedIterator[T]
+}
+
+// Creates an iterator that yields the elements of a double-ended iterator in reverse order.
+func Rev[T any](inner DoubleEndedIterator[T]) *revIterator[T] {
+	return &revIterator[T]{
+		inner: inner,
+	}
+}
+
+func (r *revIterator[T]) Next() option.Option[T] {
+	return r.inner.NextBack()
+}
+
+func (r *revIterator[T]) NextBack() option.Option[T] {
+	return r.inner.Next()
+}
+
+// Returns the bounds on the remaining length, which are those of the inner iterator.
+func (r *revIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	return SizeHint[T](r.inner)
+}
+
+// Close closes the inner iterator if it implements Closer.
+func (r *revIterator[T]) Close() {
+	closeIter(r.inner)
+}
@@ -0,0 +1,36 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+func TestRev(t *testing.T) {
+	t.Run("range", func(t *testing.T) {
+		iter := iterator.Rev[int](iterator.Range(0, 5))
+		expected := []int{4, 3, 2, 1, 0}
+		actual := iterator.Collect[int](iter)
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fail()
+		}
+	})
+	t.Run("slice", func(t *testing.T) {
+		iter := iterator.Rev[string](sliceutil.Iter([]string{"a", "b", "c"}))
+		expected := []string{"c", "b", "a"}
+		actual := iterator.Collect[string](iter)
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fail()
+		}
+	})
+	t.Run("rev rev", func(t *testing.T) {
+		iter := iterator.Rev[int](iterator.Rev[int](sliceutil.Iter([]int{1, 2, 3})))
+		expected := []int{1, 2, 3}
+		actual := iterator.Collect[int](iter)
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package iterator
+
+import (
+	"unicode/utf8"
+
+	"github.com/sidkurella/goption/option"
+)
+
+const (
+	surrogateMin = 0xD800
+	surrogateMax = 0xDFFF
+)
+
+// An iterator over a range of valid Unicode code points.
+type runeRangeIterator struct {
+	front rune // The next rune to return from the front.
+	back  rune // The next rune to return from the back.
+}
+
+// RuneRange returns an iterator over the runes from start (inclusive) to end (inclusive) that are valid Unicode
+// code points, as reported by utf8.ValidRune. Surrogate halves are skipped, and the range is clamped to
+// [0, utf8.MaxRune]. If end is less than start, the iterator will be empty.
+func RuneRange(start rune, end rune) *runeRangeIterator {
+	start = max(start, 0)
+	if start >= surrogateMin && start <= surrogateMax {
+		start = surrogateMax + 1
+	}
+	end = min(end, utf8.MaxRune)
+	if end >= surrogateMin && end <= surrogateMax {
+		end = surrogateMin - 1
+	}
+	return &runeRangeIterator{
+		front: start,
+		back:  end,
+	}
+}
+
+func (r *runeRangeIterator) Next() option.Option[rune] {
+	if r.front > r.back {
+		return option.Nothing[rune]()
+	}
+	ret := r.front
+	r.front++
+	if r.front == surrogateMin {
+		r.front = surrogateMax + 1
+	}
+	return option.Some(ret)
+}
+
+// Returns the next rune from the back of the range.
+func (r *runeRangeIterator) NextBack() option.Option[rune] {
+	if r.front > r.back {
+		return option.Nothing[rune]()
+	}
+	ret := r.back
+	r.back--
+	if r.back == surrogateMax {
+		r.back = surrogateMin - 1
+	}
+	return option.Some(ret)
+}
+
+// Returns the number of runes remaining in the range.
+func (r *runeRangeIterator) Len() uint64 {
+	if r.front > r.back {
+		return 0
+	}
+	n := uint64(r.back-r.front) + 1
+	if r.front < surrogateMin && r.back > surrogateMax {
+		n -= surrogateMax - surrogateMin + 1
+	}
+	return n
+}
+
+// Returns the bounds on the remaining length, which is known exactly.
+func (r *runeRangeIterator) SizeHint() (uint64, option.Option[uint64]) {
+	return exactSizeHint(r.Len())
+}
+
+// Returns an independent copy of the range at its current position.
+func (r *runeRangeIterator) Clone() Iterator[rune] {
+	c := *r
+	return &c
+}
@@ -0,0 +1,46 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+func TestRuneRange(t *testing.T) {
+	t.Run("letters", func(t *testing.T) {
+		if string(iterator.Collect[rune](iterator.RuneRange('a', 'e'))) != "abcde" {
+			t.Fail()
+		}
+	})
+	t.Run("skips surrogates", func(t *testing.T) {
+		iter := iterator.RuneRange(0xD7FE, 0xE001)
+		if iter.Len() != 4 {
+			t.Fail()
+		}
+		if !reflect.DeepEqual(iterator.Collect[rune](iter), []rune{0xD7FE, 0xD7FF, 0xE000, 0xE001}) {
+			t.Fail()
+		}
+	})
+	t.Run("backwards", func(t *testing.T) {
+		actual := iterator.Collect[rune](iterator.Rev[rune](iterator.RuneRange(0xD7FF, 0xE000)))
+		if !reflect.DeepEqual(actual, []rune{0xE000, 0xD7FF}) {
+			t.Fail()
+		}
+	})
+	t.Run("all valid runes", func(t *testing.T) {
+		iter := iterator.RuneRange(-5, utf8.MaxRune+5)
+		if iter.Len() != utf8.MaxRune+1-2048 {
+			t.Fail()
+		}
+		if !iterator.All[rune](iter, utf8.ValidRune) {
+			t.Fail()
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		if iterator.RuneRange('z', 'a').Next().IsSome() || iterator.RuneRange(0xD800, 0xDFFF).Len() != 0 {
+			t.Fail()
+		}
+	})
+}
@@ -29,3 +29,8 @@ func (s *scanIterator[T, S, U]) Next() option.Option[U] {
 		},
 	)
 }
+
+// Close closes the inner iterator if it implements Closer.
+func (s *scanIterator[T, S, U]) Close() {
+	closeIter(s.inner)
+}
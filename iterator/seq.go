@@ -23,11 +23,36 @@ type Closer interface {
 // calls to Next. Any adapters (such as Map, Filter, Take, etc.) that wrap this
 // iterator will also return Nothing, since they delegate to the underlying
 // iterator's Next method.
+//
+// Every adapter in this package is itself a CloseableIterator: closing the
+// adapter closes the iterators it wraps, for those that implement Closer.
+// It is therefore enough to Close the outermost adapter of a pipeline.
 type CloseableIterator[T any] interface {
 	Iterator[T]
 	Closer
 }
 
+// closeIter closes iter if it implements Closer.
+func closeIter(iter any) {
+	if c, ok := iter.(Closer); ok {
+		c.Close()
+	}
+}
+
+// ForEachAndClose runs the given closure on each element of the iterator, like ForEach,
+// and then closes the iterator. The iterator is closed even if f panics.
+func ForEachAndClose[T any](iter CloseableIterator[T], f func(T)) {
+	defer iter.Close()
+	ForEach[T](iter, f)
+}
+
+// CollectAndClose returns all the elements of the iterator into a slice, like Collect,
+// and then closes the iterator. The iterator is closed even if it panics.
+func CollectAndClose[T any](iter CloseableIterator[T]) []T {
+	defer iter.Close()
+	return Collect[T](iter)
+}
+
 // ToSeq converts an Iterator[T] to an iter.Seq[T].
 // The returned sequence can be used in a for-range loop.
 // The iterator will be consumed as the sequence is iterated.
@@ -53,10 +78,9 @@ func ToSeq[T any](it Iterator[T]) iter.Seq[T] {
 // ensures resources are released even if the iterator is not fully consumed.
 // Close is safe to call multiple times or after the iterator is exhausted.
 //
-// Important: You should defer Close even if the iterator is chained into
-// other adapters like Map, Filter, Take, etc. The adapters do not propagate
-// Close to the underlying iterator, so the original CloseableIterator must
-// be closed explicitly.
+// Adapters like Map, Filter, Take, etc. propagate Close to the iterator they
+// wrap, so closing the outermost adapter of a pipeline also closes this
+// iterator. Closing both is safe.
 //
 // Example:
 //
@@ -65,6 +89,11 @@ func ToSeq[T any](it Iterator[T]) iter.Seq[T] {
 //	// Safe to chain into adapters after deferring Close
 //	mapped := iterator.Map(it, func(x int) int { return x * 2 })
 //	result := iterator.Collect(mapped)
+//
+// Alternatively, close the pipeline through its outermost adapter:
+//
+//	it := iterator.FromSeq(slices.Values(mySlice))
+//	result := iterator.CollectAndClose(iterator.Take(it, 2))
 func FromSeq[T any](seq iter.Seq[T]) *seqIterator[T] {
 	next, stop := iter.Pull(seq)
 	return &seqIterator[T]{
@@ -130,10 +159,9 @@ func ToSeq2[K any, V any](it Iterator[pair.Pair[K, V]]) iter.Seq2[K, V] {
 // ensures resources are released even if the iterator is not fully consumed.
 // Close is safe to call multiple times or after the iterator is exhausted.
 //
-// Important: You should defer Close even if the iterator is chained into
-// other adapters like Map, Filter, Take, etc. The adapters do not propagate
-// Close to the underlying iterator, so the original CloseableIterator must
-// be closed explicitly.
+// Adapters like Map, Filter, Take, etc. propagate Close to the iterator they
+// wrap, so closing the outermost adapter of a pipeline also closes this
+// iterator. Closing both is safe.
 //
 // Example:
 //
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
 	"github.com/sidkurella/goption/pair"
 )
 
@@ -880,3 +881,142 @@ func TestCloseAfterAdapter(t *testing.T) {
 		}
 	})
 }
+
+// fakeCloseableIterator records whether it was closed.
+type fakeCloseableIterator struct {
+	fakeIterator
+	closed bool
+}
+
+func (f *fakeCloseableIterator) Next() option.Option[int] {
+	if f.closed {
+		return option.Nothing[int]()
+	}
+	return f.fakeIterator.Next()
+}
+
+func (f *fakeCloseableIterator) Close() {
+	f.closed = true
+}
+
+func TestClosePropagation(t *testing.T) {
+	adapters := map[string]func(iterator.Iterator[int]) iterator.Closer{
+		"Map": func(it iterator.Iterator[int]) iterator.Closer {
+			return iterator.Map(it, func(x int) int { return x })
+		},
+		"Filter": func(it iterator.Iterator[int]) iterator.Closer {
+			return iterator.Filter(it, func(int) bool { return true })
+		},
+		"Take": func(it iterator.Iterator[int]) iterator.Closer {
+			return iterator.Take(it, 1)
+		},
+		"Skip": func(it iterator.Iterator[int]) iterator.Closer {
+			return iterator.Skip(it, 1)
+		},
+		"Enumerate": func(it iterator.Iterator[int]) iterator.Closer {
+			return iterator.Enumerate(it)
+		},
+		"Peekable": func(it iterator.Iterator[int]) iterator.Closer {
+			return iterator.Peekable(it)
+		},
+		"Chunks": func(it iterator.Iterator[int]) iterator.Closer {
+			return iterator.Chunks(it, 2)
+		},
+		"Nested": func(it iterator.Iterator[int]) iterator.Closer {
+			return iterator.StepBy[int](iterator.Inspect(iterator.Fuse(it), func(int) {}), 2)
+		},
+	}
+	for name, adapt := range adapters {
+		t.Run(name, func(t *testing.T) {
+			source := &fakeCloseableIterator{fakeIterator: fakeIterator{elements: []int{1, 2, 3}}}
+			adapt(source).Close()
+			if !source.closed {
+				t.Errorf("%v did not close its source", name)
+			}
+		})
+	}
+
+	t.Run("after buffering from the back", func(t *testing.T) {
+		source := &fakeCloseableIterator{fakeIterator: fakeIterator{elements: []int{1, 2, 3}}}
+		m := iterator.Map[int](source, func(x int) int { return x })
+		if m.NextBack().Unwrap() != 3 {
+			t.Fail()
+		}
+		m.Close()
+		if !source.closed {
+			t.Error("Map did not close its source after buffering it")
+		}
+	})
+
+	t.Run("Chain closes both", func(t *testing.T) {
+		first := &fakeCloseableIterator{}
+		second := &fakeCloseableIterator{}
+		iterator.Chain[int](first, second).Close()
+		if !first.closed || !second.closed {
+			t.Fail()
+		}
+	})
+
+	t.Run("Zip closes both", func(t *testing.T) {
+		first := &fakeCloseableIterator{}
+		second := &fakeCloseableIterator{}
+		iterator.Zip[int, int](first, second).Close()
+		if !first.closed || !second.closed {
+			t.Fail()
+		}
+	})
+
+	t.Run("FromSeq through adapter", func(t *testing.T) {
+		closed := false
+		seq := func(yield func(int) bool) {
+			defer func() { closed = true }()
+			for i := 1; i <= 10; i++ {
+				if !yield(i) {
+					return
+				}
+			}
+		}
+		mapped := iterator.Map(iterator.FromSeq(seq), func(x int) int { return x * 2 })
+		if mapped.Next().Unwrap() != 2 {
+			t.Fail()
+		}
+		mapped.Close()
+		if !closed {
+			t.Error("expected sequence cleanup to have run after closing the adapter")
+		}
+		if mapped.Next().IsSome() {
+			t.Error("expected Nothing after Close")
+		}
+	})
+}
+
+func TestCollectAndClose(t *testing.T) {
+	t.Run("closes on completion", func(t *testing.T) {
+		source := &fakeCloseableIterator{fakeIterator: fakeIterator{elements: []int{1, 2, 3}}}
+		actual := iterator.CollectAndClose[int](iterator.Take[int](source, 2))
+		if !reflect.DeepEqual(actual, []int{1, 2}) {
+			t.Fail()
+		}
+		if !source.closed {
+			t.Fail()
+		}
+	})
+	t.Run("closes on panic", func(t *testing.T) {
+		source := &fakeCloseableIterator{fakeIterator: fakeIterator{elements: []int{1, 2, 3}}}
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected panic")
+				}
+			}()
+			iterator.ForEachAndClose[int](iterator.Map(source, func(x int) int { return x }), func(x int) {
+				if x == 2 {
+					panic("boom")
+				}
+			})
+		}()
+		if !source.closed {
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package iterator
+
+import (
+	"math"
+
+	"github.com/sidkurella/goption/option"
+)
+
+// SizeHinter is implemented by iterators that can report bounds on the number of elements they have remaining.
+// SizeHint returns a lower bound and an optional upper bound, where Nothing means the upper bound is unknown
+// or does not fit in a uint64.
+// An implementation must never report a lower bound greater than, or an upper bound less than, the actual
+// number of remaining elements.
+type SizeHinter interface {
+	SizeHint() (uint64, option.Option[uint64])
+}
+
+// ExactSizeIterator is an iterator that knows exactly how many elements it has remaining.
+type ExactSizeIterator[T any] interface {
+	Iterator[T]
+	Len() uint64
+}
+
+// exactDoubleEndedIterator is an iterator that can be iterated from the back and knows its remaining length.
+type exactDoubleEndedIterator[T any] interface {
+	DoubleEndedIterator[T]
+	ExactSizeIterator[T]
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+// If the iterator does not implement SizeHinter, returns (0, Nothing).
+func SizeHint[T any](iter Iterator[T]) (uint64, option.Option[uint64]) {
+	if s, ok := iter.(SizeHinter); ok {
+		return s.SizeHint()
+	}
+	return 0, option.Nothing[uint64]()
+}
+
+// exactSizeHint returns the size hint of an iterator that knows its remaining length exactly.
+func exactSizeHint(n uint64) (uint64, option.Option[uint64]) {
+	return n, option.Some(n)
+}
+
+// capacityHint returns the iterator's remaining length for use as a slice capacity, if its size hint gives it
+// exactly. Otherwise, it returns 0, and the slice is left to grow as elements arrive, since a large lower bound
+// alone would preallocate far more than may be needed at once.
+func capacityHint[T any](iter Iterator[T]) int {
+	lower, upper := SizeHint(iter)
+	if upper != option.Some(lower) {
+		return 0
+	}
+	if lower > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int(lower)
+}
+
+// saturatingAdd returns a + b, or MaxUint64 if the addition overflows.
+func saturatingAdd(a uint64, b uint64) uint64 {
+	if a > math.MaxUint64-b {
+		return math.MaxUint64
+	}
+	return a + b
+}
+
+// saturatingSub returns a - b, or 0 if the subtraction underflows.
+func saturatingSub(a uint64, b uint64) uint64 {
+	if b > a {
+		return 0
+	}
+	return a - b
+}
+
+// minUpper returns the smaller of two optional upper bounds, where Nothing represents no bound.
+func minUpper(a option.Option[uint64], b option.Option[uint64]) option.Option[uint64] {
+	aVal, aOk := a.Get()
+	bVal, bOk := b.Get()
+	switch {
+	case !aOk:
+		return b
+	case !bOk:
+		return a
+	default:
+		return option.Some(min(aVal, bVal))
+	}
+}
+
+// addUpper returns the sum of two optional upper bounds.
+// Returns Nothing if either bound is unknown or the sum overflows.
+func addUpper(a option.Option[uint64], b option.Option[uint64]) option.Option[uint64] {
+	aVal, aOk := a.Get()
+	bVal, bOk := b.Get()
+	if !aOk || !bOk || aVal > math.MaxUint64-bVal {
+		return option.Nothing[uint64]()
+	}
+	return option.Some(aVal + bVal)
+}
+
+// saturatingMul returns a * b, or MaxUint64 if the multiplication overflows.
+func saturatingMul(a uint64, b uint64) uint64 {
+	if a != 0 && b > math.MaxUint64/a {
+		return math.MaxUint64
+	}
+	return a * b
+}
+
+// mulUpper returns the product of two optional upper bounds.
+// Returns Nothing if either bound is unknown or the product overflows.
+func mulUpper(a option.Option[uint64], b option.Option[uint64]) option.Option[uint64] {
+	aVal, aOk := a.Get()
+	bVal, bOk := b.Get()
+	if !aOk || !bOk || (aVal != 0 && bVal > math.MaxUint64/aVal) {
+		return option.Nothing[uint64]()
+	}
+	return option.Some(aVal * bVal)
+}
+
+// countdown tracks the remaining length of an iterator whose total length is computed up front.
+// The total may not fit in a uint64, in which case left saturates and only serves as a lower bound.
+type countdown struct {
+	left  uint64
+	exact bool
+}
+
+// dec records that an element was yielded.
+func (c *countdown) dec() {
+	c.left = saturatingSub(c.left, 1)
+}
+
+// hint returns the bounds on the remaining length.
+func (c *countdown) hint() (uint64, option.Option[uint64]) {
+	if c.exact {
+		return exactSizeHint(c.left)
+	}
+	return c.left, option.Nothing[uint64]()
+}
@@ -0,0 +1,127 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/pair"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+type sizeHint struct {
+	lower uint64
+	upper option.Option[uint64]
+}
+
+func hintOf[T any](iter iterator.Iterator[T]) sizeHint {
+	lower, upper := iterator.SizeHint(iter)
+	return sizeHint{lower: lower, upper: upper}
+}
+
+func exact(n uint64) sizeHint {
+	return sizeHint{lower: n, upper: option.Some(n)}
+}
+
+func TestSizeHint(t *testing.T) {
+	tests := []struct {
+		name     string
+		actual   sizeHint
+		expected sizeHint
+	}{
+		{
+			name:     "unknown",
+			actual:   hintOf[int](&fakeIterator{elements: []int{1, 2}}),
+			expected: sizeHint{lower: 0, upper: option.Nothing[uint64]()},
+		},
+		{
+			name:     "slice",
+			actual:   hintOf[int](sliceutil.Iter([]int{1, 2, 3})),
+			expected: exact(3),
+		},
+		{
+			name:     "range",
+			actual:   hintOf[int](iterator.RangeBy(0, 10, 3)),
+			expected: exact(4),
+		},
+		{
+			name:     "range zero step",
+			actual:   hintOf[int](iterator.RangeBy(0, 10, 0)),
+			expected: sizeHint{lower: ^uint64(0), upper: option.Nothing[uint64]()},
+		},
+		{
+			name:     "take",
+			actual:   hintOf[int](iterator.Take[int](iterator.Range(0, 10), 4)),
+			expected: exact(4),
+		},
+		{
+			name:     "take unknown",
+			actual:   hintOf[int](iterator.Take[int](&fakeIterator{}, 4)),
+			expected: sizeHint{lower: 0, upper: option.Some(uint64(4))},
+		},
+		{
+			name:     "skip",
+			actual:   hintOf[int](iterator.Skip[int](iterator.Range(0, 10), 4)),
+			expected: exact(6),
+		},
+		{
+			name:     "skip past end",
+			actual:   hintOf[int](iterator.Skip[int](iterator.Range(0, 3), 4)),
+			expected: exact(0),
+		},
+		{
+			name:     "zip",
+			actual:   hintOf[pair.Pair[int, int]](iterator.Zip[int, int](iterator.Range(0, 10), iterator.Range(0, 3))),
+			expected: exact(3),
+		},
+		{
+			name:     "chain",
+			actual:   hintOf[int](iterator.Chain[int](iterator.Range(0, 10), iterator.Range(0, 3))),
+			expected: exact(13),
+		},
+		{
+			name:     "chain unknown",
+			actual:   hintOf[int](iterator.Chain[int](iterator.Range(0, 10), &fakeIterator{})),
+			expected: sizeHint{lower: 10, upper: option.Nothing[uint64]()},
+		},
+		{
+			name:     "step by",
+			actual:   hintOf[int](iterator.StepBy[int](iterator.Range(0, 10), 3)),
+			expected: exact(4),
+		},
+		{
+			name:     "filter",
+			actual:   hintOf[int](iterator.Filter[int](iterator.Range(0, 10), func(int) bool { return true })),
+			expected: sizeHint{lower: 0, upper: option.Some(uint64(10))},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.actual, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, tt.actual)
+			}
+		})
+	}
+}
+
+func TestSizeHint_StepByAfterFirst(t *testing.T) {
+	iter := iterator.StepBy[int](iterator.Range(0, 10), 3)
+	iter.Next()
+	if !reflect.DeepEqual(hintOf[int](iter), exact(3)) {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(iterator.Collect[int](iter), []int{3, 6, 9}) {
+		t.Fail()
+	}
+}
+
+func TestCollect_PreSized(t *testing.T) {
+	actual := iterator.Collect[int](iterator.Range(0, 5))
+	if cap(actual) != 5 {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(actual, []int{0, 1, 2, 3, 4}) {
+		t.Fail()
+	}
+}
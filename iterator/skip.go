@@ -25,3 +25,19 @@ func (s *skipIterator[T]) Next() option.Option[T] {
 	}
 	return s.inner.Next()
 }
+
+// Returns the bounds on the remaining length, accounting for any elements not yet skipped.
+func (s *skipIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	lower, upper := SizeHint(s.inner)
+	if s.skipped {
+		return lower, upper
+	}
+	return saturatingSub(lower, s.n), option.Map(upper, func(u uint64) uint64 {
+		return saturatingSub(u, s.n)
+	})
+}
+
+// Close closes the inner iterator if it implements Closer.
+func (s *skipIterator[T]) Close() {
+	closeIter(s.inner)
+}
@@ -31,3 +31,8 @@ func (s *skipWhileIterator[T]) Next() option.Option[T] {
 	}
 	return s.inner.Next()
 }
+
+// Close closes the inner iterator if it implements Closer.
+func (s *skipWhileIterator[T]) Close() {
+	closeIter(s.inner)
+}
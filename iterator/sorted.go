@@ -0,0 +1,24 @@
+package iterator
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Collects the iterator and returns an iterator over its elements in ascending order.
+// The entire iterator is consumed by this.
+func Sorted[T cmp.Ordered](iter Iterator[T]) *bufferIterator[T] {
+	data := Collect(iter)
+	slices.Sort(data)
+	return newBufferIterator(data)
+}
+
+// Collects the iterator and returns an iterator over its elements sorted with respect to compare.
+// compare(a, b) should return a negative number if a < b, zero if a == b, and a positive number if a > b.
+// The sort is stable, so equal elements keep their original order.
+// The entire iterator is consumed by this.
+func SortedBy[T any](iter Iterator[T], compare func(T, T) int) *bufferIterator[T] {
+	data := Collect(iter)
+	slices.SortStableFunc(data, compare)
+	return newBufferIterator(data)
+}
@@ -0,0 +1,41 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/pair"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+func TestSorted(t *testing.T) {
+	iter := iterator.Sorted[int](&fakeIterator{elements: []int{3, 1, 2}})
+	expected := []int{1, 2, 3}
+	actual := iterator.Collect[int](iter)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+}
+
+func TestSortedBy(t *testing.T) {
+	iter := iterator.SortedBy[pair.Pair[int, string]](
+		sliceutil.Iter([]pair.Pair[int, string]{
+			{First: 2, Second: "a"},
+			{First: 1, Second: "b"},
+			{First: 2, Second: "c"},
+		}),
+		func(a pair.Pair[int, string], b pair.Pair[int, string]) int {
+			return a.First - b.First
+		},
+	)
+	expected := []pair.Pair[int, string]{
+		{First: 1, Second: "b"},
+		{First: 2, Second: "a"},
+		{First: 2, Second: "c"},
+	}
+	actual := iterator.Collect[pair.Pair[int, string]](iter)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+}
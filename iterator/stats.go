@@ -0,0 +1,192 @@
+package iterator
+
+import (
+	"math"
+	"slices"
+
+	"github.com/sidkurella/goption/option"
+)
+
+// DefaultStatsBins is the number of bins Summarize uses for its percentile sketch.
+const DefaultStatsBins = 128
+
+// Stats is a streaming statistical summary of a sequence of numbers.
+// Count, mean, variance, minimum and maximum are exact. Percentiles are estimated from a sketch of bounded size:
+// they are exact while at most bins distinct values have been added, and approximate afterwards.
+type Stats struct {
+	count  uint64
+	mean   float64
+	m2     float64 // The sum of squared differences from the mean, as in Welford's algorithm.
+	min    float64
+	max    float64
+	bins   int
+	sketch []centroid // Sorted by value.
+}
+
+// A centroid summarizes count values whose mean is value.
+// Unless merged is set, every one of those values is equal to value.
+type centroid struct {
+	value  float64
+	count  uint64
+	merged bool
+}
+
+// NewStats returns an empty summary whose percentile sketch holds at most bins centroids.
+// More bins improve percentile accuracy at the cost of memory and time per added value.
+// A value of bins less than 2 is treated as 2.
+func NewStats(bins int) *Stats {
+	return &Stats{
+		bins:   max(bins, 2),
+		sketch: make([]centroid, 0, max(bins, 2)+1),
+	}
+}
+
+// Summarize consumes the iterator, returning a statistical summary of its elements.
+// The percentile sketch uses DefaultStatsBins bins.
+func Summarize[T numeric](iter Iterator[T]) *Stats {
+	s := NewStats(DefaultStatsBins)
+	ForEach(iter, func(t T) {
+		s.Add(float64(t))
+	})
+	return s
+}
+
+// Add adds a value to the summary.
+func (s *Stats) Add(x float64) {
+	s.count++
+	if s.count == 1 {
+		s.min, s.max = x, x
+	} else {
+		s.min, s.max = min(s.min, x), max(s.max, x)
+	}
+	delta := x - s.mean
+	s.mean += delta / float64(s.count)
+	s.m2 += delta * (x - s.mean)
+
+	i, found := slices.BinarySearchFunc(s.sketch, x, func(c centroid, x float64) int {
+		switch {
+		case c.value < x:
+			return -1
+		case c.value > x:
+			return 1
+		default:
+			return 0
+		}
+	})
+	if found {
+		s.sketch[i].count++
+		return
+	}
+	s.sketch = slices.Insert(s.sketch, i, centroid{value: x, count: 1})
+	if len(s.sketch) > s.bins {
+		s.compress()
+	}
+}
+
+// compress merges the two adjacent centroids that are closest together.
+func (s *Stats) compress() {
+	closest := 0
+	for i := 1; i < len(s.sketch)-1; i++ {
+		if s.sketch[i+1].value-s.sketch[i].value < s.sketch[closest+1].value-s.sketch[closest].value {
+			closest = i
+		}
+	}
+	a, b := s.sketch[closest], s.sketch[closest+1]
+	count := a.count + b.count
+	s.sketch[closest] = centroid{
+		value:  (a.value*float64(a.count) + b.value*float64(b.count)) / float64(count),
+		count:  count,
+		merged: true,
+	}
+	s.sketch = slices.Delete(s.sketch, closest+1, closest+2)
+}
+
+// Count returns the number of values in the summary.
+func (s *Stats) Count() uint64 {
+	return s.count
+}
+
+// Mean returns the arithmetic mean of the values. Returns Nothing if the summary is empty.
+func (s *Stats) Mean() option.Option[float64] {
+	if s.count == 0 {
+		return option.Nothing[float64]()
+	}
+	return option.Some(s.mean)
+}
+
+// Variance returns the population variance of the values. Returns Nothing if the summary is empty.
+func (s *Stats) Variance() option.Option[float64] {
+	if s.count == 0 {
+		return option.Nothing[float64]()
+	}
+	return option.Some(s.m2 / float64(s.count))
+}
+
+// SampleVariance returns the sample variance of the values, using Bessel's correction.
+// Returns Nothing if the summary has fewer than two values.
+func (s *Stats) SampleVariance() option.Option[float64] {
+	if s.count < 2 {
+		return option.Nothing[float64]()
+	}
+	return option.Some(s.m2 / float64(s.count-1))
+}
+
+// StdDev returns the population standard deviation of the values. Returns Nothing if the summary is empty.
+func (s *Stats) StdDev() option.Option[float64] {
+	return option.Map(s.Variance(), math.Sqrt)
+}
+
+// Min returns the minimum value. Returns Nothing if the summary is empty.
+func (s *Stats) Min() option.Option[float64] {
+	if s.count == 0 {
+		return option.Nothing[float64]()
+	}
+	return option.Some(s.min)
+}
+
+// Max returns the maximum value. Returns Nothing if the summary is empty.
+func (s *Stats) Max() option.Option[float64] {
+	if s.count == 0 {
+		return option.Nothing[float64]()
+	}
+	return option.Some(s.max)
+}
+
+// Percentile returns the pth percentile of the values, where p is between 0 and 100.
+// Values of p outside that range are clamped to it. Between ranks, the result is linearly interpolated.
+// Returns Nothing if the summary is empty.
+func (s *Stats) Percentile(p float64) option.Option[float64] {
+	if s.count == 0 {
+		return option.Nothing[float64]()
+	}
+	rank := min(max(p, 0), 100) / 100 * float64(s.count-1)
+
+	// An unmerged centroid covers the ranks of all its values exactly. A merged centroid is placed at the middle
+	// rank of the values it summarizes. The minimum and maximum anchor the ends at ranks 0 and count-1.
+	prevRank, prevValue := 0.0, s.min
+	cumulative := 0.0
+	for _, c := range s.sketch {
+		lo, hi := cumulative, cumulative+float64(c.count-1)
+		if c.merged {
+			lo = (lo + hi) / 2
+			hi = lo
+		}
+		if rank < lo {
+			return option.Some(interpolate(prevRank, prevValue, lo, c.value, rank))
+		}
+		if rank <= hi {
+			return option.Some(c.value)
+		}
+		prevRank, prevValue = hi, c.value
+		cumulative += float64(c.count)
+	}
+	return option.Some(interpolate(prevRank, prevValue, float64(s.count-1), s.max, rank))
+}
+
+// interpolate returns the value at x on the line between (x0, y0) and (x1, y1).
+func interpolate(x0 float64, y0 float64, x1 float64, y1 float64, x float64) float64 {
+	if x1 <= x0 {
+		return y1
+	}
+	return y0 + (y1-y0)*(x-x0)/(x1-x0)
+}
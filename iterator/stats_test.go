@@ -0,0 +1,61 @@
+package iterator_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+func TestSummarize(t *testing.T) {
+	t.Run("summary", func(t *testing.T) {
+		s := iterator.Summarize[int](sliceutil.Iter([]int{2, 4, 4, 4, 5, 5, 7, 9}))
+		if s.Count() != 8 {
+			t.Fail()
+		}
+		if s.Mean() != option.Some(5.0) {
+			t.Fail()
+		}
+		if s.Variance() != option.Some(4.0) || s.StdDev() != option.Some(2.0) {
+			t.Fail()
+		}
+		if s.SampleVariance() != option.Some(32.0/7) {
+			t.Fail()
+		}
+		if s.Min() != option.Some(2.0) || s.Max() != option.Some(9.0) {
+			t.Fail()
+		}
+	})
+	t.Run("exact percentiles", func(t *testing.T) {
+		s := iterator.Summarize[int](sliceutil.Iter([]int{1, 1, 3, 5}))
+		expected := map[float64]float64{0: 1, 25: 1, 50: 2, 100: 5, 150: 5}
+		for p, v := range expected {
+			if s.Percentile(p) != option.Some(v) {
+				t.Errorf("p%v: expected %v, got %v", p, v, s.Percentile(p))
+			}
+		}
+	})
+	t.Run("approximate percentiles", func(t *testing.T) {
+		s := iterator.NewStats(32)
+		iterator.ForEach[int](iterator.Range(0, 10001), func(i int) {
+			s.Add(float64((i * 7919) % 10001)) // Every value in [0, 10000], in scrambled order.
+		})
+		for _, p := range []float64{1, 10, 50, 90, 99} {
+			actual := s.Percentile(p).Unwrap()
+			if math.Abs(actual-p*100) > 150 {
+				t.Errorf("p%v: expected about %v, got %v", p, p*100, actual)
+			}
+		}
+		if s.Percentile(0) != option.Some(0.0) || s.Percentile(100) != option.Some(10000.0) {
+			t.Fail()
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		s := iterator.Summarize[int](&fakeIterator{})
+		if s.Mean().IsSome() || s.Variance().IsSome() || s.Min().IsSome() || s.Percentile(50).IsSome() {
+			t.Fail()
+		}
+	})
+}
@@ -25,3 +25,28 @@ func (s *stepByIterator[T]) Next() option.Option[T] {
 	}
 	return Nth(s.inner, s.n-1)
 }
+
+// Returns the bounds on the remaining length, given the bounds of the inner iterator.
+func (s *stepByIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	lower, upper := SizeHint(s.inner)
+	steps := func(n uint64) uint64 {
+		switch {
+		case !s.gotFirst && n == 0:
+			return 0
+		case !s.gotFirst && s.n == 0:
+			return 1
+		case !s.gotFirst:
+			return 1 + (n-1)/s.n
+		case s.n == 0:
+			return 0
+		default:
+			return n / s.n
+		}
+	}
+	return steps(lower), option.Map(upper, steps)
+}
+
+// Close closes the inner iterator if it implements Closer.
+func (s *stepByIterator[T]) Close() {
+	closeIter(s.inner)
+}
@@ -0,0 +1,260 @@
+package iterator
+
+import (
+	"context"
+	"iter"
+
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/pair"
+	"github.com/sidkurella/goption/result"
+)
+
+// Stream wraps an iterator so that pipelines can be written left-to-right as method chains:
+//
+//	iterator.StreamOf(it).Filter(pred).Skip(1).Take(10).Collect()
+//
+// Go does not allow methods to introduce type parameters, so only the adapters that keep the element type are
+// methods. Adapters that change it are package-level functions prefixed with Stream, such as StreamMap, which
+// take and return a Stream.
+//
+// A Stream is itself an iterator, and it forwards SizeHint, Err and Close to the iterator it wraps.
+// Like any iterator, a Stream is consumed as it is used: call one method on it, then continue with its result.
+type Stream[T any] struct {
+	iter Iterator[T]
+}
+
+// StreamOf wraps the iterator in a Stream.
+func StreamOf[T any](iter Iterator[T]) Stream[T] {
+	return Stream[T]{
+		iter: iter,
+	}
+}
+
+// StreamMap returns a stream of the elements of s mapped by f. See Map.
+func StreamMap[T any, U any](s Stream[T], f func(T) U) Stream[U] {
+	return StreamOf[U](Map(s.iter, f))
+}
+
+// StreamFilterMap returns a stream that both filters and maps the elements of s. See FilterMap.
+func StreamFilterMap[T any, U any](s Stream[T], f func(T) option.Option[U]) Stream[U] {
+	return StreamOf[U](FilterMap(s.iter, f))
+}
+
+// StreamFlatMap returns a stream of the elements of the iterators that f returns for each element of s.
+// See FlatMap.
+func StreamFlatMap[T any, U any](s Stream[T], f func(T) Iterator[U]) Stream[U] {
+	return StreamOf[U](FlatMap(s.iter, f))
+}
+
+// StreamTryMap returns a stream of the elements of s mapped by f, stopping at the first error.
+// The stream's Err reports the error. See TryMap.
+func StreamTryMap[T any, U any](s Stream[T], f func(T) (U, error)) Stream[U] {
+	return StreamOf[U](TryMap(s.iter, f))
+}
+
+// StreamEnumerate returns a stream of the elements of s paired with their index. See Enumerate.
+func StreamEnumerate[T any](s Stream[T]) Stream[pair.Pair[int, T]] {
+	return StreamOf[pair.Pair[int, T]](Enumerate(s.iter))
+}
+
+// StreamZip returns a stream of pairs of the elements of s and other. See Zip.
+func StreamZip[T any, U any](s Stream[T], other Iterator[U]) Stream[pair.Pair[T, U]] {
+	return StreamOf[pair.Pair[T, U]](Zip(s.iter, other))
+}
+
+// StreamChunks returns a stream of the elements of s in chunks of n. See Chunks.
+func StreamChunks[T any](s Stream[T], n uint64) Stream[[]T] {
+	return StreamOf[[]T](Chunks(s.iter, n))
+}
+
+// StreamWindows returns a stream of overlapping windows of n elements of s. See Windows.
+func StreamWindows[T any](s Stream[T], n uint64) Stream[[]T] {
+	return StreamOf[[]T](Windows(s.iter, n))
+}
+
+// Iter returns the iterator wrapped by the stream.
+func (s Stream[T]) Iter() Iterator[T] {
+	return s.iter
+}
+
+// Seq returns the stream as an iter.Seq, for use with range. See ToSeq.
+func (s Stream[T]) Seq() iter.Seq[T] {
+	return ToSeq(s.iter)
+}
+
+func (s Stream[T]) Next() option.Option[T] {
+	return s.iter.Next()
+}
+
+// Returns the bounds on the remaining length, which are those of the wrapped iterator.
+func (s Stream[T]) SizeHint() (uint64, option.Option[uint64]) {
+	return SizeHint(s.iter)
+}
+
+// Err returns the wrapped iterator's error if it is a FallibleIterator. Returns nil otherwise.
+func (s Stream[T]) Err() error {
+	return errOf(s.iter)
+}
+
+// Close closes the wrapped iterator if it implements Closer.
+func (s Stream[T]) Close() {
+	closeIter(s.iter)
+}
+
+// Filter returns a stream of the elements that pass pred. See Filter.
+func (s Stream[T]) Filter(pred func(T) bool) Stream[T] {
+	return StreamOf[T](Filter(s.iter, pred))
+}
+
+// TryFilter returns a stream of the elements that pass pred, stopping at the first error. See TryFilter.
+func (s Stream[T]) TryFilter(pred func(T) (bool, error)) Stream[T] {
+	return StreamOf[T](TryFilter(s.iter, pred))
+}
+
+// Take returns a stream of the first n elements. See Take.
+func (s Stream[T]) Take(n uint64) Stream[T] {
+	return StreamOf[T](Take(s.iter, n))
+}
+
+// Skip returns a stream that skips the first n elements. See Skip.
+func (s Stream[T]) Skip(n uint64) Stream[T] {
+	return StreamOf[T](Skip(s.iter, n))
+}
+
+// TakeWhile returns a stream of the elements up to the first that fails pred. See TakeWhile.
+func (s Stream[T]) TakeWhile(pred func(T) bool) Stream[T] {
+	return StreamOf[T](TakeWhile(s.iter, pred))
+}
+
+// SkipWhile returns a stream that skips elements while they pass pred. See SkipWhile.
+func (s Stream[T]) SkipWhile(pred func(T) bool) Stream[T] {
+	return StreamOf[T](SkipWhile(s.iter, pred))
+}
+
+// StepBy returns a stream of every nth element, starting with the first. See StepBy.
+func (s Stream[T]) StepBy(n uint64) Stream[T] {
+	return StreamOf[T](StepBy(s.iter, n))
+}
+
+// Inspect returns a stream that calls f on each element as it passes through. See Inspect.
+func (s Stream[T]) Inspect(f func(T)) Stream[T] {
+	return StreamOf[T](Inspect(s.iter, f))
+}
+
+// Chain returns a stream of the elements of this stream followed by those of other. See Chain.
+func (s Stream[T]) Chain(other Iterator[T]) Stream[T] {
+	return StreamOf[T](Chain(s.iter, other))
+}
+
+// Fuse returns a stream that returns Nothing forever once the wrapped iterator first does. See Fuse.
+func (s Stream[T]) Fuse() Stream[T] {
+	return StreamOf[T](Fuse(s.iter))
+}
+
+// Cycle returns a stream that repeats the elements endlessly. See Cycle.
+func (s Stream[T]) Cycle() Stream[T] {
+	return StreamOf[T](Cycle(s.iter))
+}
+
+// Rev returns a stream of the elements in reverse order.
+// If the wrapped iterator is not a DoubleEndedIterator, its elements are buffered first.
+func (s Stream[T]) Rev() Stream[T] {
+	return StreamOf[T](Rev(backOf(&s.iter)))
+}
+
+// DedupBy returns a stream in which consecutive elements that are the same by same are collapsed into one.
+// See DedupBy.
+func (s Stream[T]) DedupBy(same func(T, T) bool) Stream[T] {
+	return StreamOf[T](DedupBy(s.iter, same))
+}
+
+// Intersperse returns a stream with item placed between each pair of adjacent elements. See Intersperse.
+func (s Stream[T]) Intersperse(item T) Stream[T] {
+	return StreamOf[T](Intersperse(s.iter, item))
+}
+
+// SortedBy returns a stream of the elements stably sorted by compare. See SortedBy.
+func (s Stream[T]) SortedBy(compare func(T, T) int) Stream[T] {
+	return StreamOf[T](SortedBy(s.iter, compare))
+}
+
+// WithContext returns a stream that stops once ctx is done. See WithContext.
+func (s Stream[T]) WithContext(ctx context.Context) Stream[T] {
+	return StreamOf[T](WithContext(ctx, s.iter))
+}
+
+// Peekable returns a peekable iterator over the elements. Wrap it with StreamOf to continue the pipeline.
+// See Peekable.
+func (s Stream[T]) Peekable() *peekableIterator[T] {
+	return Peekable(s.iter)
+}
+
+// Collect consumes the stream, returning its elements in a slice. See Collect.
+func (s Stream[T]) Collect() []T {
+	return Collect(s.iter)
+}
+
+// Count consumes the stream, returning the number of elements. See Count.
+func (s Stream[T]) Count() uint64 {
+	return Count(s.iter)
+}
+
+// ForEach consumes the stream, calling f on each element. See ForEach.
+func (s Stream[T]) ForEach(f func(T)) {
+	ForEach(s.iter, f)
+}
+
+// Find returns the first element that passes pred. See Find.
+func (s Stream[T]) Find(pred func(T) bool) option.Option[T] {
+	return Find(s.iter, pred)
+}
+
+// Position returns the index of the first element that passes pred. See Position.
+func (s Stream[T]) Position(pred func(T) bool) option.Option[uint64] {
+	return Position(s.iter, pred)
+}
+
+// Any returns true if any element passes pred. See Any.
+func (s Stream[T]) Any(pred func(T) bool) bool {
+	return Any(s.iter, pred)
+}
+
+// All returns true if every element passes pred. See All.
+func (s Stream[T]) All(pred func(T) bool) bool {
+	return All(s.iter, pred)
+}
+
+// Nth returns the nth element, counting from 0. See Nth.
+func (s Stream[T]) Nth(n uint64) option.Option[T] {
+	return Nth(s.iter, n)
+}
+
+// Last returns the last element of the stream, consuming it unless the element can be taken from the back. See Last.
+func (s Stream[T]) Last() option.Option[T] {
+	return Last(s.iter)
+}
+
+// AdvanceBy skips the next n elements. See AdvanceBy.
+func (s Stream[T]) AdvanceBy(n uint64) result.Result[struct{}, uint64] {
+	return AdvanceBy(s.iter, n)
+}
+
+// Reduce consumes the stream, folding it using the first element as the initial value. See Reduce.
+func (s Stream[T]) Reduce(f func(T, T) T) option.Option[T] {
+	return Reduce(s.iter, f)
+}
+
+// MaxBy consumes the stream, returning its maximum element according to less. See MaxBy.
+func (s Stream[T]) MaxBy(less func(T, T) bool) option.Option[T] {
+	return MaxBy(s.iter, less)
+}
+
+// MinBy consumes the stream, returning its minimum element according to less. See MinBy.
+func (s Stream[T]) MinBy(less func(T, T) bool) option.Option[T] {
+	return MinBy(s.iter, less)
+}
+
+// Partition consumes the stream, splitting its elements by whether they pass pred. See Partition.
+func (s Stream[T]) Partition(pred func(T) bool) ([]T, []T) {
+	return Partition(s.iter, pred)
+}
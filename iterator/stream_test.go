@@ -0,0 +1,100 @@
+package iterator_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/pair"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+func TestStream(t *testing.T) {
+	t.Run("method chain", func(t *testing.T) {
+		actual := iterator.StreamOf[int](iterator.Range(0, 100)).
+			Filter(func(x int) bool { return x%3 == 0 }).
+			Skip(1).
+			StepBy(2).
+			Take(4).
+			Collect()
+		if !reflect.DeepEqual(actual, []int{3, 9, 15, 21}) {
+			t.Fail()
+		}
+	})
+	t.Run("type-changing functions", func(t *testing.T) {
+		s := iterator.StreamOf[int](sliceutil.Iter([]int{1, 2, 3, 4}))
+		strs := iterator.StreamMap(s.Filter(func(x int) bool { return x != 2 }), strconv.Itoa)
+		actual := iterator.StreamEnumerate(strs.Rev()).Collect()
+		expected := []pair.Pair[int, string]{{First: 0, Second: "4"}, {First: 1, Second: "3"}, {First: 2, Second: "1"}}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fail()
+		}
+	})
+	t.Run("chunks and flat map", func(t *testing.T) {
+		chunks := iterator.StreamChunks(iterator.StreamOf[int](iterator.Range(0, 5)), 2)
+		sums := iterator.StreamFlatMap(chunks, func(c []int) iterator.Iterator[int] {
+			return iterator.Once(iterator.Sum[int](sliceutil.Iter(c)))
+		})
+		if !reflect.DeepEqual(sums.Collect(), []int{1, 5, 4}) {
+			t.Fail()
+		}
+	})
+	t.Run("terminals", func(t *testing.T) {
+		s := func() iterator.Stream[int] {
+			return iterator.StreamOf[int](sliceutil.Iter([]int{5, 1, 4, 2}))
+		}
+		if s().Count() != 4 {
+			t.Fail()
+		}
+		if s().Find(func(x int) bool { return x < 3 }) != option.Some(1) {
+			t.Fail()
+		}
+		if s().MaxBy(func(a, b int) bool { return a < b }) != option.Some(5) {
+			t.Fail()
+		}
+		if s().Reduce(func(a, b int) int { return a + b }) != option.Some(12) {
+			t.Fail()
+		}
+		if !reflect.DeepEqual(s().SortedBy(func(a, b int) int { return a - b }).Collect(), []int{1, 2, 4, 5}) {
+			t.Fail()
+		}
+		sum := 0
+		for x := range s().Seq() {
+			sum += x
+		}
+		if sum != 12 {
+			t.Fail()
+		}
+	})
+	t.Run("forwards Err and Close", func(t *testing.T) {
+		source := &fakeCloseableIterator{fakeIterator: fakeIterator{elements: []int{1, 2}}}
+		s := iterator.StreamTryMap(iterator.StreamOf[int](source), func(x int) (int, error) {
+			if x == 2 {
+				return 0, errFake
+			}
+			return x, nil
+		})
+		if !reflect.DeepEqual(s.Collect(), []int{1}) {
+			t.Fail()
+		}
+		if !errors.Is(s.Err(), errFake) {
+			t.Fail()
+		}
+		s.Close()
+		if !source.closed {
+			t.Fail()
+		}
+	})
+	t.Run("with context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		s := iterator.StreamOf[int](iterator.Repeat(1)).WithContext(ctx)
+		if s.Next().IsSome() || !errors.Is(s.Err(), context.Canceled) {
+			t.Fail()
+		}
+	})
+}
@@ -22,3 +22,14 @@ func (t *takeIterator[T]) Next() option.Option[T] {
 	}
 	return option.Nothing[T]()
 }
+
+// Returns the bounds on the remaining length, which never exceed the number of elements left to take.
+func (t *takeIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	lower, upper := SizeHint(t.inner)
+	return min(lower, t.left), minUpper(upper, option.Some(t.left))
+}
+
+// Close closes the inner iterator if it implements Closer.
+func (t *takeIterator[T]) Close() {
+	closeIter(t.inner)
+}
@@ -34,3 +34,8 @@ func (s *takeWhileIterator[T]) Next() option.Option[T] {
 	s.done = true
 	return option.Nothing[T]()
 }
+
+// Close closes the inner iterator if it implements Closer.
+func (s *takeWhileIterator[T]) Close() {
+	closeIter(s.inner)
+}
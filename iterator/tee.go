@@ -0,0 +1,128 @@
+package iterator
+
+import "github.com/sidkurella/goption/option"
+
+// The state shared by the iterators returned by Tee.
+type teeBuffer[T any] struct {
+	inner     Iterator[T]
+	buf       []T      // Elements pulled from inner that some active consumer has not yet returned.
+	base      uint64   // The position in inner of buf[0].
+	positions []uint64 // The position in inner of the next element each consumer will return.
+	active    []bool   // Whether each consumer is still open.
+	done      bool     // Whether inner has returned Nothing.
+}
+
+// One of the iterators returned by Tee.
+type teeIterator[T any] struct {
+	shared *teeBuffer[T]
+	id     int
+}
+
+// Tee consumes the iterator through n independent iterators, each of which yields every remaining element of iter.
+// Elements are pulled from iter as the furthest-ahead consumer needs them and buffered until every open consumer
+// has passed them, so memory use grows with the distance between the fastest and slowest consumers.
+// Closing a consumer stops it from holding back the buffer; once every consumer is closed, iter is closed too
+// if it implements Closer.
+//
+// The returned iterators share state and must not be used concurrently. Tee takes ownership of iter: it must not
+// be used directly afterwards. For iterators that implement Cloner, Clone is cheaper, as it needs no buffer.
+// If n is 0, there is no consumer to close iter later, so it is closed immediately. Panics if n is negative.
+func Tee[T any](iter Iterator[T], n int) []*teeIterator[T] {
+	if n < 0 {
+		panic("iterator: tee count must be non-negative")
+	}
+	if n == 0 {
+		closeIter(iter)
+	}
+	shared := &teeBuffer[T]{
+		inner:     iter,
+		buf:       nil,
+		base:      0,
+		positions: make([]uint64, n),
+		active:    make([]bool, n),
+		done:      false,
+	}
+	ret := make([]*teeIterator[T], n)
+	for i := range ret {
+		shared.active[i] = true
+		ret[i] = &teeIterator[T]{
+			shared: shared,
+			id:     i,
+		}
+	}
+	return ret
+}
+
+func (t *teeIterator[T]) Next() option.Option[T] {
+	s := t.shared
+	if !s.active[t.id] {
+		return option.Nothing[T]()
+	}
+	offset := s.positions[t.id] - s.base
+	if offset == uint64(len(s.buf)) {
+		if s.done {
+			return option.Nothing[T]()
+		}
+		val, ok := s.inner.Next().Get()
+		if !ok {
+			s.done = true
+			return option.Nothing[T]()
+		}
+		s.buf = append(s.buf, val)
+	}
+	ret := s.buf[offset]
+	s.positions[t.id]++
+	s.release()
+	return option.Some(ret)
+}
+
+// release drops the buffered elements that every active consumer has passed.
+func (s *teeBuffer[T]) release() {
+	lowest := s.base + uint64(len(s.buf))
+	for i, pos := range s.positions {
+		if s.active[i] {
+			lowest = min(lowest, pos)
+		}
+	}
+	k := lowest - s.base
+	if k == 0 {
+		return
+	}
+	clear(s.buf[:k]) // Allow the released elements to be garbage collected.
+	s.buf = s.buf[k:]
+	s.base = lowest
+}
+
+// Returns the bounds on the remaining length: the buffered elements this consumer has not yet returned, plus
+// those remaining in the source iterator.
+func (t *teeIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	s := t.shared
+	if !s.active[t.id] {
+		return exactSizeHint(0)
+	}
+	buffered := s.base + uint64(len(s.buf)) - s.positions[t.id]
+	if s.done {
+		return exactSizeHint(buffered)
+	}
+	lower, upper := SizeHint(s.inner)
+	return saturatingAdd(lower, buffered), addUpper(upper, option.Some(buffered))
+}
+
+// Close stops this consumer, so that it no longer holds elements in the shared buffer.
+// Once every consumer is closed, the source iterator is closed if it implements Closer.
+// After Close is called, Next will return Nothing. It is safe to call Close multiple times.
+func (t *teeIterator[T]) Close() {
+	s := t.shared
+	if !s.active[t.id] {
+		return
+	}
+	s.active[t.id] = false
+	for _, a := range s.active {
+		if a {
+			s.release()
+			return
+		}
+	}
+	s.buf = nil
+	closeIter(s.inner)
+}
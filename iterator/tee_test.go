@@ -0,0 +1,83 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+)
+
+func TestTee(t *testing.T) {
+	t.Run("each consumer sees every element", func(t *testing.T) {
+		tees := iterator.Tee[int](&fakeIterator{elements: []int{1, 2, 3}}, 2)
+		if iterator.Count[int](tees[0]) != 3 {
+			t.Fail()
+		}
+		if !reflect.DeepEqual(iterator.Collect[int](tees[1]), []int{1, 2, 3}) {
+			t.Fail()
+		}
+	})
+	t.Run("interleaved", func(t *testing.T) {
+		pulled := 0
+		source := iterator.Inspect[int](&fakeIterator{elements: []int{1, 2, 3}}, func(int) { pulled++ })
+		tees := iterator.Tee[int](source, 3)
+		if tees[0].Next() != option.Some(1) || tees[1].Next() != option.Some(1) {
+			t.Fail()
+		}
+		if tees[1].Next() != option.Some(2) || tees[0].Next() != option.Some(2) {
+			t.Fail()
+		}
+		if pulled != 2 {
+			t.Errorf("expected 2 elements pulled from the source, got %v", pulled)
+		}
+		if !reflect.DeepEqual(iterator.Collect[int](tees[2]), []int{1, 2, 3}) {
+			t.Fail()
+		}
+		if tees[0].Next() != option.Some(3) || tees[0].Next().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("size hint", func(t *testing.T) {
+		tees := iterator.Tee[int](iterator.Range(0, 5), 2)
+		tees[0].Next()
+		tees[0].Next()
+		lower, upper := tees[1].SizeHint()
+		if lower != 5 || upper != option.Some[uint64](5) {
+			t.Fail()
+		}
+		lower, upper = tees[0].SizeHint()
+		if lower != 3 || upper != option.Some[uint64](3) {
+			t.Fail()
+		}
+	})
+	t.Run("close", func(t *testing.T) {
+		source := &fakeCloseableIterator{fakeIterator: fakeIterator{elements: []int{1, 2, 3}}}
+		tees := iterator.Tee[int](source, 2)
+		tees[0].Close()
+		if tees[0].Next().IsSome() || source.closed {
+			t.Fail()
+		}
+		if tees[1].Next() != option.Some(1) {
+			t.Fail()
+		}
+		tees[1].Close()
+		if !source.closed {
+			t.Fail()
+		}
+	})
+	t.Run("no consumers closes the source", func(t *testing.T) {
+		source := &fakeCloseableIterator{fakeIterator: fakeIterator{elements: []int{1}}}
+		if len(iterator.Tee[int](source, 0)) != 0 || !source.closed {
+			t.Fail()
+		}
+	})
+	t.Run("negative count", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Fail()
+			}
+		}()
+		iterator.Tee[int](iterator.Range(0, 1), -1)
+	})
+}
@@ -0,0 +1,72 @@
+package iterator
+
+import (
+	"time"
+
+	"github.com/sidkurella/goption/option"
+)
+
+// An iterator that limits the rate at which elements are pulled from its inner iterator.
+type throttleIterator[T any] struct {
+	inner    Iterator[T]
+	n        uint64
+	interval time.Duration
+	clock    Clock
+	times    []time.Time // The times of the most recent n pulls, as a ring buffer.
+	pos      int         // The position in times of the oldest pull, once times is full.
+}
+
+// Throttle creates an iterator that pulls at most n elements from inner in any period of length interval.
+// Next blocks, by waiting on clock, until pulling another element would not exceed the rate.
+// If clock is nil, SystemClock is used. Panics if n is 0.
+func Throttle[T any](inner Iterator[T], n uint64, interval time.Duration, clock Clock) *throttleIterator[T] {
+	if n == 0 {
+		panic("iterator: throttle rate must be non-zero")
+	}
+	return &throttleIterator[T]{
+		inner:    inner,
+		n:        n,
+		interval: interval,
+		clock:    clockOrSystem(clock),
+		times:    nil,
+		pos:      0,
+	}
+}
+
+func (t *throttleIterator[T]) Next() option.Option[T] {
+	if uint64(len(t.times)) == t.n {
+		if wait := t.times[t.pos].Add(t.interval).Sub(t.clock.Now()); wait > 0 {
+			<-t.clock.After(wait)
+		}
+	}
+	ret := t.inner.Next()
+	if ret.IsSome() {
+		t.record(t.clock.Now())
+	}
+	return ret
+}
+
+// record adds the time of a pull to the ring buffer, replacing the oldest once it is full.
+func (t *throttleIterator[T]) record(now time.Time) {
+	if uint64(len(t.times)) < t.n {
+		t.times = append(t.times, now)
+		return
+	}
+	t.times[t.pos] = now
+	t.pos = (t.pos + 1) % len(t.times)
+}
+
+// Returns the bounds on the remaining length, which are those of the inner iterator.
+func (t *throttleIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	return SizeHint(t.inner)
+}
+
+// Err returns the inner iterator's error if it is a FallibleIterator. Returns nil otherwise.
+func (t *throttleIterator[T]) Err() error {
+	return errOf(t.inner)
+}
+
+// Close closes the inner iterator if it implements Closer.
+func (t *throttleIterator[T]) Close() {
+	closeIter(t.inner)
+}
@@ -0,0 +1,33 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+func TestThrottle(t *testing.T) {
+	clock := newFakeClock(true)
+	start := clock.Now()
+	iter := iterator.Throttle[int](iterator.Range(0, 5), 2, time.Second, clock)
+	var offsets []time.Duration
+	iterator.ForEach[int](iter, func(int) {
+		offsets = append(offsets, clock.Now().Sub(start))
+	})
+	expected := []time.Duration{0, 0, time.Second, time.Second, 2 * time.Second}
+	if !reflect.DeepEqual(offsets, expected) {
+		t.Errorf("expected %v, got %v", expected, offsets)
+	}
+}
+
+func TestThrottle_SlowConsumer(t *testing.T) {
+	clock := newFakeClock(false)
+	iter := iterator.Throttle[int](iterator.Range(0, 3), 1, time.Second, clock)
+	iter.Next()
+	clock.Advance(2 * time.Second) // The interval has already passed, so the next element need not wait.
+	if iter.Next().IsNothing() || clock.calls != 0 {
+		t.Fail()
+	}
+}
@@ -0,0 +1,58 @@
+package iterator
+
+import (
+	"errors"
+	"time"
+
+	"github.com/sidkurella/goption/option"
+)
+
+// ErrTimeout is reported by the Err method of an iterator created by Timeout when an element took too long.
+var ErrTimeout = errors.New("iterator: timed out waiting for the next element")
+
+// An iterator that stops if an element takes too long to arrive.
+type timeoutIterator[T any] struct {
+	timedSource[T]
+	d time.Duration
+}
+
+// Timeout creates an iterator that yields the elements of inner, but stops if any call to Next would wait longer
+// than d for the next element. Once it has timed out, Next returns Nothing from then on, and Err reports
+// ErrTimeout. If clock is nil, SystemClock is used.
+//
+// Elements are pulled from inner on a background goroutine, which may pull one element ahead of the consumer.
+// Defer Close, as with FromSeq, if the iterator may not be consumed to exhaustion.
+// If inner is a FallibleIterator, its error is reported by Err.
+func Timeout[T any](inner Iterator[T], d time.Duration, clock Clock) *timeoutIterator[T] {
+	return &timeoutIterator[T]{
+		timedSource: newTimedSource(inner, clock),
+		d:           d,
+	}
+}
+
+func (t *timeoutIterator[T]) Next() option.Option[T] {
+	if t.done {
+		return option.Nothing[T]()
+	}
+	f := t.start()
+	select {
+	case item, open := <-f.items:
+		if !open {
+			t.finish()
+			return option.Nothing[T]()
+		}
+		return option.Some(f.unwrap(item))
+	case <-t.clock.After(t.d):
+		// An element that arrived just as the timer fired is still in time.
+		val, ok, open := f.tryRecv()
+		switch {
+		case ok:
+			return option.Some(val)
+		case !open:
+			t.finish()
+		default:
+			t.fail(ErrTimeout)
+		}
+		return option.Nothing[T]()
+	}
+}
@@ -0,0 +1,64 @@
+package iterator_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+)
+
+func TestTimeout(t *testing.T) {
+	t.Run("times out", func(t *testing.T) {
+		clock := newFakeClock(false)
+		ch := make(chan int, 1)
+		defer close(ch)
+		ch <- 1
+		iter := iterator.Timeout[int](iterator.FromChan(ch), time.Second, clock)
+		defer iter.Close()
+		if iter.Next() != option.Some(1) {
+			t.Fail()
+		}
+		results := make(chan option.Option[int])
+		go func() {
+			results <- iter.Next()
+		}()
+		clock.WaitForCalls(2)
+		clock.Advance(time.Second)
+		if (<-results).IsSome() {
+			t.Fail()
+		}
+		if !errors.Is(iter.Err(), iterator.ErrTimeout) {
+			t.Fail()
+		}
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("exhausted in time", func(t *testing.T) {
+		iter := iterator.Timeout[int](iterator.Range(0, 3), time.Hour, newFakeClock(false))
+		if iterator.Count[int](iter) != 3 || iter.Err() != nil {
+			t.Fail()
+		}
+	})
+	t.Run("inner error", func(t *testing.T) {
+		inner := &fakeFallibleIterator{fakeIterator: fakeIterator{elements: []int{1}}, err: errFake}
+		iter := iterator.Timeout[int](inner, time.Hour, newFakeClock(false))
+		iterator.Collect[int](iter)
+		if !errors.Is(iter.Err(), errFake) {
+			t.Fail()
+		}
+	})
+	t.Run("inner panic", func(t *testing.T) {
+		iter := iterator.Timeout[int](iterator.FromFn(func() option.Option[int] {
+			panic("boom")
+		}), time.Hour, newFakeClock(false))
+		defer func() {
+			if recover() != "boom" {
+				t.Fail()
+			}
+		}()
+		iter.Next()
+	})
+}
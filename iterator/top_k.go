@@ -0,0 +1,109 @@
+package iterator
+
+import (
+	"cmp"
+	"container/heap"
+	"slices"
+
+	"github.com/sidkurella/goption/pair"
+)
+
+// kHeap is a heap of candidate elements whose root is the candidate that would be evicted first.
+type kHeap[T any] struct {
+	elems []mergeHead[T]
+	worse func(mergeHead[T], mergeHead[T]) bool
+}
+
+func (h *kHeap[T]) Len() int {
+	return len(h.elems)
+}
+
+func (h *kHeap[T]) Less(i int, j int) bool {
+	return h.worse(h.elems[i], h.elems[j])
+}
+
+func (h *kHeap[T]) Swap(i int, j int) {
+	h.elems[i], h.elems[j] = h.elems[j], h.elems[i]
+}
+
+func (h *kHeap[T]) Push(x any) {
+	h.elems = append(h.elems, x.(mergeHead[T]))
+}
+
+func (h *kHeap[T]) Pop() any {
+	last := h.elems[len(h.elems)-1]
+	h.elems = h.elems[:len(h.elems)-1]
+	return last
+}
+
+// TopK consumes the iterator, returning its k largest elements with respect to compare, in descending order.
+// Among equal elements, those that came first are preferred and listed first.
+// Only k elements are held at a time, so this takes O(n log k) time and O(k) memory.
+// compare(a, b) should return a negative number if a < b, zero if a == b, and a positive number if a > b.
+func TopK[T any](iter Iterator[T], k uint64, compare func(T, T) int) []T {
+	return smallestK(iter, k, func(a T, b T) int {
+		return compare(b, a)
+	})
+}
+
+// BottomK consumes the iterator, returning its k smallest elements with respect to compare, in ascending order.
+// Among equal elements, those that came first are preferred and listed first.
+// Only k elements are held at a time, so this takes O(n log k) time and O(k) memory.
+// compare(a, b) should return a negative number if a < b, zero if a == b, and a positive number if a > b.
+func BottomK[T any](iter Iterator[T], k uint64, compare func(T, T) int) []T {
+	return smallestK(iter, k, compare)
+}
+
+// KSmallestByKey consumes the iterator, returning the k elements with the smallest keys, in ascending order of key.
+// key is called once per element. Among elements with equal keys, those that came first are preferred and listed
+// first. Only k elements are held at a time, so this takes O(n log k) time and O(k) memory.
+func KSmallestByKey[T any, K cmp.Ordered](iter Iterator[T], k uint64, key func(T) K) []T {
+	keyed := Map(iter, func(t T) pair.Pair[K, T] {
+		return pair.Pair[K, T]{First: key(t), Second: t}
+	})
+	smallest := smallestK[pair.Pair[K, T]](keyed, k, func(a pair.Pair[K, T], b pair.Pair[K, T]) int {
+		return cmp.Compare(a.First, b.First)
+	})
+	ret := make([]T, len(smallest))
+	for i, p := range smallest {
+		ret[i] = p.Second
+	}
+	return ret
+}
+
+// smallestK returns the k smallest elements of iter in ascending order, preferring earlier elements among equals.
+func smallestK[T any](iter Iterator[T], k uint64, compare func(T, T) int) []T {
+	if k == 0 {
+		return []T{}
+	}
+	// Order candidates by value, then by position, so that the root is the largest and latest.
+	order := func(a mergeHead[T], b mergeHead[T]) int {
+		if c := compare(a.val, b.val); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.index, b.index)
+	}
+	h := &kHeap[T]{
+		elems: make([]mergeHead[T], 0, min(k, uint64(capacityHint(iter)))),
+		worse: func(a mergeHead[T], b mergeHead[T]) bool {
+			return order(a, b) > 0
+		},
+	}
+	index := 0
+	ForEach(iter, func(t T) {
+		candidate := mergeHead[T]{val: t, index: index}
+		index++
+		if uint64(h.Len()) < k {
+			heap.Push(h, candidate)
+		} else if order(candidate, h.elems[0]) < 0 {
+			h.elems[0] = candidate
+			heap.Fix(h, 0)
+		}
+	})
+	slices.SortFunc(h.elems, order)
+	ret := make([]T, len(h.elems))
+	for i, e := range h.elems {
+		ret[i] = e.val
+	}
+	return ret
+}
@@ -0,0 +1,60 @@
+package iterator_test
+
+import (
+	"cmp"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+func TestTopK(t *testing.T) {
+	t.Run("largest", func(t *testing.T) {
+		iter := sliceutil.Iter([]int{5, 1, 9, 3, 7, 9, 2})
+		if !reflect.DeepEqual(iterator.TopK[int](iter, 3, cmp.Compare[int]), []int{9, 9, 7}) {
+			t.Fail()
+		}
+	})
+	t.Run("fewer than k", func(t *testing.T) {
+		iter := sliceutil.Iter([]int{2, 3, 1})
+		if !reflect.DeepEqual(iterator.TopK[int](iter, 5, cmp.Compare[int]), []int{3, 2, 1}) {
+			t.Fail()
+		}
+	})
+	t.Run("zero", func(t *testing.T) {
+		if !reflect.DeepEqual(iterator.TopK[int](iterator.Range(0, 5), 0, cmp.Compare[int]), []int{}) {
+			t.Fail()
+		}
+	})
+	t.Run("ties prefer earlier elements", func(t *testing.T) {
+		iter := sliceutil.Iter([]string{"bb", "a", "cc", "dd", "e"})
+		byLen := func(a string, b string) int { return cmp.Compare(len(a), len(b)) }
+		if !reflect.DeepEqual(iterator.TopK[string](iter, 2, byLen), []string{"bb", "cc"}) {
+			t.Fail()
+		}
+	})
+}
+
+func TestBottomK(t *testing.T) {
+	iter := iterator.Map[int](iterator.Range(0, 1000), func(x int) int { return (x * 7919) % 1000 })
+	if !reflect.DeepEqual(iterator.BottomK[int](iter, 4, cmp.Compare[int]), []int{0, 1, 2, 3}) {
+		t.Fail()
+	}
+}
+
+func TestKSmallestByKey(t *testing.T) {
+	calls := 0
+	iter := sliceutil.Iter([]string{"Banana", "apple", "cherry", "Apricot"})
+	actual := iterator.KSmallestByKey[string](iter, 2, func(s string) string {
+		calls++
+		return strings.ToLower(s)
+	})
+	if !reflect.DeepEqual(actual, []string{"apple", "Apricot"}) {
+		t.Fail()
+	}
+	if calls != 4 {
+		t.Errorf("expected key to be called once per element, got %v calls", calls)
+	}
+}
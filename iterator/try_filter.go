@@ -0,0 +1,62 @@
+package iterator
+
+import "github.com/sidkurella/goption/option"
+
+// An iterator that only returns elements that pass a fallible predicate, stopping at the first error.
+type tryFilterIterator[T any] struct {
+	inner Iterator[T]
+	pred  func(T) (bool, error)
+	err   error
+}
+
+// Returns an iterator that only returns elements from iter that pass pred.
+// If pred returns an error, the iterator stops: Next returns Nothing from then on, and Err reports the error.
+// If the inner iterator is a FallibleIterator, its error is reported by Err as well.
+func TryFilter[T any](iter Iterator[T], pred func(T) (bool, error)) *tryFilterIterator[T] {
+	return &tryFilterIterator[T]{
+		inner: iter,
+		pred:  pred,
+		err:   nil,
+	}
+}
+
+// Returns the next element from the inner iterator that passes the filter predicate.
+func (f *tryFilterIterator[T]) Next() option.Option[T] {
+	for f.err == nil {
+		val, ok := f.inner.Next().Get()
+		if !ok {
+			break
+		}
+		pass, err := f.pred(val)
+		if err != nil {
+			f.err = err
+			break
+		}
+		if pass {
+			return option.Some(val)
+		}
+	}
+	return option.Nothing[T]()
+}
+
+// Err returns the error that stopped the iterator, from either pred or the inner iterator. Returns nil otherwise.
+func (f *tryFilterIterator[T]) Err() error {
+	if f.err != nil {
+		return f.err
+	}
+	return errOf(f.inner)
+}
+
+// Returns the bounds on the remaining length. Any element may be filtered out, so the lower bound is 0.
+func (f *tryFilterIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	if f.err != nil {
+		return exactSizeHint(0)
+	}
+	_, upper := SizeHint(f.inner)
+	return 0, upper
+}
+
+// Close closes the inner iterator if it implements Closer.
+func (f *tryFilterIterator[T]) Close() {
+	closeIter(f.inner)
+}
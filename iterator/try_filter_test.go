@@ -0,0 +1,42 @@
+package iterator_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+func TestTryFilter(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		i1 := &fakeIterator{elements: []int{1, 2, 3, 4}}
+		iter := iterator.TryFilter[int](i1, func(x int) (bool, error) { return x%2 == 0, nil })
+		actual := iterator.Collect[int](iter)
+		if !reflect.DeepEqual(actual, []int{2, 4}) {
+			t.Fail()
+		}
+		if iter.Err() != nil {
+			t.Fail()
+		}
+	})
+	t.Run("stops at first error", func(t *testing.T) {
+		i1 := &fakeIterator{elements: []int{1, 2, 3, 4}}
+		iter := iterator.TryFilter[int](i1, func(x int) (bool, error) {
+			if x == 3 {
+				return false, errFake
+			}
+			return true, nil
+		})
+		actual := iterator.Collect[int](iter)
+		if !reflect.DeepEqual(actual, []int{1, 2}) {
+			t.Fail()
+		}
+		if !errors.Is(iter.Err(), errFake) {
+			t.Fail()
+		}
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package iterator
+
+import "github.com/sidkurella/goption/option"
+
+// An iterator that maps elements with a fallible function, stopping at the first error.
+type tryMapIterator[T any, U any] struct {
+	inner Iterator[T]
+	f     func(T) (U, error)
+	err   error
+}
+
+// Creates an iterator that maps elements from the original iterator with f.
+// If f returns an error, the iterator stops: Next returns Nothing from then on, and Err reports the error.
+// If the inner iterator is a FallibleIterator, its error is reported by Err as well.
+func TryMap[T any, U any](iter Iterator[T], f func(T) (U, error)) *tryMapIterator[T, U] {
+	return &tryMapIterator[T, U]{
+		inner: iter,
+		f:     f,
+		err:   nil,
+	}
+}
+
+func (m *tryMapIterator[T, U]) Next() option.Option[U] {
+	if m.err != nil {
+		return option.Nothing[U]()
+	}
+	val, ok := m.inner.Next().Get()
+	if !ok {
+		return option.Nothing[U]()
+	}
+	ret, err := m.f(val)
+	if err != nil {
+		m.err = err
+		return option.Nothing[U]()
+	}
+	return option.Some(ret)
+}
+
+// Err returns the error that stopped the iterator, from either f or the inner iterator. Returns nil otherwise.
+func (m *tryMapIterator[T, U]) Err() error {
+	if m.err != nil {
+		return m.err
+	}
+	return errOf(m.inner)
+}
+
+// Returns the bounds on the remaining length. The iterator may stop at any element, so the lower bound is 0.
+func (m *tryMapIterator[T, U]) SizeHint() (uint64, option.Option[uint64]) {
+	if m.err != nil {
+		return exactSizeHint(0)
+	}
+	_, upper := SizeHint(m.inner)
+	return 0, upper
+}
+
+// Close closes the inner iterator if it implements Closer.
+func (m *tryMapIterator[T, U]) Close() {
+	closeIter(m.inner)
+}
@@ -0,0 +1,49 @@
+package iterator_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+func TestTryMap(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		iter := iterator.TryMap(sliceutil.Iter([]string{"1", "2", "3"}), strconv.Atoi)
+		actual := iterator.Collect[int](iter)
+		if !reflect.DeepEqual(actual, []int{1, 2, 3}) {
+			t.Fail()
+		}
+		if iter.Err() != nil {
+			t.Fail()
+		}
+	})
+	t.Run("stops at first error", func(t *testing.T) {
+		iter := iterator.TryMap(sliceutil.Iter([]string{"1", "x", "3"}), strconv.Atoi)
+		actual := iterator.Collect[int](iter)
+		if !reflect.DeepEqual(actual, []int{1}) {
+			t.Fail()
+		}
+		var numErr *strconv.NumError
+		if !errors.As(iter.Err(), &numErr) {
+			t.Fail()
+		}
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("inner error", func(t *testing.T) {
+		inner := &fakeFallibleIterator{fakeIterator: fakeIterator{elements: []int{1, 2}}, err: errFake}
+		iter := iterator.TryMap(inner, func(x int) (int, error) { return x * 2, nil })
+		actual := iterator.Collect[int](iter)
+		if !reflect.DeepEqual(actual, []int{2, 4}) {
+			t.Fail()
+		}
+		if !errors.Is(iter.Err(), errFake) {
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package iterator
+
+import (
+	"github.com/sidkurella/goption/maputil"
+	"github.com/sidkurella/goption/option"
+)
+
+type uniqueIterator[T comparable] struct {
+	inner Iterator[T]
+	seen  maputil.Map[T, struct{}] // Not a set.Set, since package set imports this package.
+}
+
+// Creates an iterator that yields only the first occurrence of each element.
+// Every distinct element is remembered in a hash set, so memory grows with the number of distinct elements.
+// To remove only consecutive duplicates, use Dedup.
+func Unique[T comparable](inner Iterator[T]) *uniqueIterator[T] {
+	return &uniqueIterator[T]{
+		inner: inner,
+		seen:  maputil.New[T, struct{}](),
+	}
+}
+
+func (u *uniqueIterator[T]) Next() option.Option[T] {
+	return Find(u.inner, func(t T) bool {
+		return u.seen.Insert(t, struct{}{}).IsNothing()
+	})
+}
+
+// Returns the bounds on the remaining length. All but one element may be a duplicate.
+func (u *uniqueIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	lower, upper := SizeHint(u.inner)
+	if u.seen.IsEmpty() {
+		return min(lower, 1), upper
+	}
+	return 0, upper
+}
+
+// Close closes the inner iterator if it implements Closer.
+func (u *uniqueIterator[T]) Close() {
+	closeIter(u.inner)
+}
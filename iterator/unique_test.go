@@ -0,0 +1,17 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+func TestUnique(t *testing.T) {
+	iter := iterator.Unique[int](&fakeIterator{elements: []int{3, 1, 3, 2, 1, 4}})
+	expected := []int{3, 1, 2, 4}
+	actual := iterator.Collect[int](iter)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fail()
+	}
+}
@@ -0,0 +1,75 @@
+package iterator
+
+import "github.com/sidkurella/goption/option"
+
+type windowsIterator[T any] struct {
+	inner Iterator[T]
+	ring  []T    // The current window, rotated so that it begins at start.
+	start int    // The index in ring of the oldest element of the window.
+	n     uint64 // The size of each window.
+	done  bool
+}
+
+// Creates an iterator that yields overlapping windows of n consecutive elements, advancing by one element each time.
+// If the iterator has fewer than n elements, no windows are yielded.
+// The window is held in a ring buffer, so each element is pulled from inner only once; each yielded window is a
+// freshly allocated slice.
+// Panics if n is 0.
+func Windows[T any](inner Iterator[T], n uint64) *windowsIterator[T] {
+	if n == 0 {
+		panic("iterator: window size must be non-zero")
+	}
+	return &windowsIterator[T]{
+		inner: inner,
+		ring:  nil,
+		start: 0,
+		n:     n,
+		done:  false,
+	}
+}
+
+func (w *windowsIterator[T]) Next() option.Option[[]T] {
+	if w.done {
+		return option.Nothing[[]T]()
+	}
+	if w.ring == nil {
+		chunk, full := takeChunk(w.inner, w.n)
+		if !full {
+			w.done = true
+			return option.Nothing[[]T]()
+		}
+		w.ring = chunk
+	} else {
+		val, ok := w.inner.Next().Get()
+		if !ok {
+			w.done = true
+			return option.Nothing[[]T]()
+		}
+		w.ring[w.start] = val
+		w.start = (w.start + 1) % len(w.ring)
+	}
+	window := make([]T, 0, len(w.ring))
+	window = append(window, w.ring[w.start:]...)
+	window = append(window, w.ring[:w.start]...)
+	return option.Some(window)
+}
+
+// Returns the bounds on the remaining number of windows.
+func (w *windowsIterator[T]) SizeHint() (uint64, option.Option[uint64]) {
+	if w.done {
+		return exactSizeHint(0)
+	}
+	windows := func(l uint64) uint64 {
+		if w.ring != nil {
+			return l
+		}
+		return saturatingSub(saturatingAdd(l, 1), w.n)
+	}
+	lower, upper := SizeHint(w.inner)
+	return windows(lower), option.Map(upper, windows)
+}
+
+// Close closes the inner iterator if it implements Closer.
+func (w *windowsIterator[T]) Close() {
+	closeIter(w.inner)
+}
@@ -0,0 +1,35 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+)
+
+func TestWindows(t *testing.T) {
+	t.Run("windows", func(t *testing.T) {
+		iter := iterator.Windows[int](iterator.Range(0, 5), 3)
+		lower, _ := iterator.SizeHint[[]int](iter)
+		if lower != 3 {
+			t.Fail()
+		}
+		expected := [][]int{{0, 1, 2}, {1, 2, 3}, {2, 3, 4}}
+		actual := iterator.Collect[[]int](iter)
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fail()
+		}
+	})
+	t.Run("too short", func(t *testing.T) {
+		iter := iterator.Windows[int](&fakeIterator{elements: []int{1, 2}}, 3)
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+	t.Run("huge size", func(t *testing.T) {
+		iter := iterator.Windows[int](iterator.Range(0, 3), 1<<62)
+		if iter.Next().IsSome() {
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,128 @@
+package iterator
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/sidkurella/goption/result"
+)
+
+// WriteError is the error returned by WriteLines, WriteCSV and WriteNDJSON when they stop early.
+// The elements encoded before the error are still flushed to the writer, unless the error came from the writer.
+type WriteError struct {
+	Written uint64 // The number of elements whose output reached the writer in full before the error.
+	Err     error  // The error from encoding, writing or the iterator.
+}
+
+func (e *WriteError) Error() string {
+	return fmt.Sprintf("iterator: write failed after %d elements: %v", e.Written, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *WriteError) Unwrap() error {
+	return e.Err
+}
+
+// WriteLines consumes the iterator, writing each element to w followed by a newline.
+// Output is buffered and flushed before returning. Returns the number of lines written, or a *WriteError holding
+// the first error from writing, or from the iterator itself if it is a FallibleIterator.
+func WriteLines(iter Iterator[string], w io.Writer) result.Result[uint64, error] {
+	return writeAll(iter, w, func(out *bufio.Writer) func(string) error {
+		return func(s string) error {
+			if _, err := out.WriteString(s); err != nil {
+				return err
+			}
+			return out.WriteByte('\n')
+		}
+	})
+}
+
+// WriteCSV consumes the iterator, writing each element to w as a CSV record produced by encode.
+// Output is buffered and flushed before returning. Returns the number of records written, or a *WriteError holding
+// the first error from writing, or from the iterator itself if it is a FallibleIterator.
+func WriteCSV[T any](iter Iterator[T], w io.Writer, encode func(T) []string) result.Result[uint64, error] {
+	return writeAll(iter, w, func(out *bufio.Writer) func(T) error {
+		cw := csv.NewWriter(out)
+		return func(t T) error {
+			if err := cw.Write(encode(t)); err != nil {
+				return err
+			}
+			cw.Flush() // Moves the record into out, which does the buffering, so that it is counted as encoded.
+			return cw.Error()
+		}
+	})
+}
+
+// WriteNDJSON consumes the iterator, writing each element to w as a line of JSON, as in newline-delimited JSON.
+// Output is buffered and flushed before returning. Returns the number of values written, or a *WriteError holding
+// the first error from encoding or writing, or from the iterator itself if it is a FallibleIterator.
+func WriteNDJSON[T any](iter Iterator[T], w io.Writer) result.Result[uint64, error] {
+	return writeAll(iter, w, func(out *bufio.Writer) func(T) error {
+		enc := json.NewEncoder(out)
+		return func(t T) error {
+			return enc.Encode(t)
+		}
+	})
+}
+
+// byteCounter counts the bytes accepted by w.
+type byteCounter struct {
+	w io.Writer
+	n uint64
+}
+
+func (c *byteCounter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += uint64(n)
+	return n, err
+}
+
+// writeAll writes each element of iter to w with the function returned by newWrite, stopping at the first error,
+// and then flushes the output.
+// The output is flushed even if writing stops early, so that the elements encoded so far are not lost. An element
+// only counts as written once all of its bytes have been accepted by w, rather than by the buffer.
+func writeAll[T any](
+	iter Iterator[T], w io.Writer, newWrite func(*bufio.Writer) func(T) error,
+) result.Result[uint64, error] {
+	flushed := &byteCounter{w: w, n: 0}
+	bw := bufio.NewWriter(flushed)
+	write := newWrite(bw)
+
+	written := uint64(0)
+	pending := []uint64(nil) // The offsets in the output at which each element not yet known to be written ends.
+	settle := func() {
+		k := 0
+		for k < len(pending) && pending[k] <= flushed.n {
+			k++
+		}
+		if k > 0 {
+			written += uint64(k)
+			pending = append(pending[:0], pending[k:]...)
+		}
+	}
+	fail := func(err error) result.Result[uint64, error] {
+		settle()
+		return result.Err[uint64, error](&WriteError{Written: written, Err: err})
+	}
+
+	for item := iter.Next(); item.IsSome(); item = iter.Next() {
+		if err := write(item.Unwrap()); err != nil {
+			_ = bw.Flush() // The error from write takes precedence; if it came from w, flushing fails with it too.
+			return fail(err)
+		}
+		// Every byte encoded so far has either been accepted by w or is still buffered.
+		pending = append(pending, flushed.n+uint64(bw.Buffered()))
+		settle()
+	}
+	if err := bw.Flush(); err != nil {
+		return fail(err)
+	}
+	settle()
+	if err := errOf(iter); err != nil {
+		return fail(err)
+	}
+	return result.Ok[uint64, error](written)
+}
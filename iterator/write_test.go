@@ -0,0 +1,144 @@
+package iterator_test
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/result"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+// failingWriter fails every write with errFake.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errFake
+}
+
+// limitedWriter accepts up to limit bytes, and then fails with errFake.
+type limitedWriter struct {
+	bytes.Buffer
+	limit int
+}
+
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	n := min(len(p), l.limit-l.Len())
+	l.Buffer.Write(p[:n])
+	if n < len(p) {
+		return n, errFake
+	}
+	return n, nil
+}
+
+// writtenOf returns the number of elements a WriteError reports as written, or -1 if err is not a WriteError.
+func writtenOf(err error) int {
+	var writeErr *iterator.WriteError
+	if !errors.As(err, &writeErr) {
+		return -1
+	}
+	return int(writeErr.Written)
+}
+
+func TestWriteLines(t *testing.T) {
+	t.Run("writes lines", func(t *testing.T) {
+		var buf bytes.Buffer
+		res := iterator.WriteLines(sliceutil.Iter([]string{"a", "b", ""}), &buf)
+		if !reflect.DeepEqual(res, result.Ok[uint64, error](3)) {
+			t.Fail()
+		}
+		if buf.String() != "a\nb\n\n" {
+			t.Fail()
+		}
+	})
+	t.Run("write error", func(t *testing.T) {
+		res := iterator.WriteLines(sliceutil.Iter([]string{"a", "b", "c"}), failingWriter{})
+		if !errors.Is(res.UnwrapErr(), errFake) {
+			t.Fail()
+		}
+		if writtenOf(res.UnwrapErr()) != 0 {
+			t.Errorf("expected nothing written, got %v", writtenOf(res.UnwrapErr()))
+		}
+	})
+	t.Run("partial write error", func(t *testing.T) {
+		w := &limitedWriter{limit: 5}
+		res := iterator.WriteLines(sliceutil.Iter([]string{"a", "b", "c"}), w)
+		if writtenOf(res.UnwrapErr()) != 2 || w.String() != "a\nb\nc" {
+			t.Errorf("expected 2 lines written, got %v: %q", writtenOf(res.UnwrapErr()), w.String())
+		}
+	})
+	t.Run("iterator error", func(t *testing.T) {
+		var buf bytes.Buffer
+		inner := &fakeFallibleIterator{fakeIterator: fakeIterator{elements: []int{1, 2}}, err: errFake}
+		res := iterator.WriteLines(iterator.TryMap(inner, func(x int) (string, error) {
+			return strconv.Itoa(x), nil
+		}), &buf)
+		if !errors.Is(res.UnwrapErr(), errFake) {
+			t.Fail()
+		}
+		if writtenOf(res.UnwrapErr()) != 2 {
+			t.Fail()
+		}
+		if buf.String() != "1\n2\n" {
+			t.Fail()
+		}
+	})
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	res := iterator.WriteCSV(sliceutil.Iter([]jsonPoint{{1, 2}, {3, 4}}), &buf, func(p jsonPoint) []string {
+		return []string{strconv.Itoa(p.X), strconv.Itoa(p.Y) + ",0"}
+	})
+	if !reflect.DeepEqual(res, result.Ok[uint64, error](2)) {
+		t.Fail()
+	}
+	if buf.String() != "1,\"2,0\"\n3,\"4,0\"\n" {
+		t.Fail()
+	}
+}
+
+func TestWriteCSV_WriteError(t *testing.T) {
+	w := &limitedWriter{limit: 6}
+	res := iterator.WriteCSV(sliceutil.Iter([]jsonPoint{{1, 2}, {3, 4}}), w, func(p jsonPoint) []string {
+		return []string{strconv.Itoa(p.X), strconv.Itoa(p.Y)}
+	})
+	if writtenOf(res.UnwrapErr()) != 1 {
+		t.Errorf("expected 1 record written, got %v", writtenOf(res.UnwrapErr()))
+	}
+}
+
+func TestWriteNDJSON(t *testing.T) {
+	t.Run("writes values", func(t *testing.T) {
+		var buf bytes.Buffer
+		res := iterator.WriteNDJSON(sliceutil.Iter([]jsonPoint{{1, 2}, {3, 4}}), &buf)
+		if !reflect.DeepEqual(res, result.Ok[uint64, error](2)) {
+			t.Fail()
+		}
+		if buf.String() != "{\"x\":1,\"y\":2}\n{\"x\":3,\"y\":4}\n" {
+			t.Fail()
+		}
+	})
+	t.Run("round trip", func(t *testing.T) {
+		var buf bytes.Buffer
+		expected := []jsonPoint{{1, 2}, {3, 4}}
+		iterator.WriteNDJSON(sliceutil.Iter(expected), &buf)
+		actual := iterator.Collect[jsonPoint](iterator.NDJSON[jsonPoint](&buf))
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fail()
+		}
+	})
+	t.Run("encode error", func(t *testing.T) {
+		var buf bytes.Buffer
+		res := iterator.WriteNDJSON(sliceutil.Iter([]any{1, 2, make(chan int), 3}), &buf)
+		if writtenOf(res.UnwrapErr()) != 2 {
+			t.Fail()
+		}
+		if buf.String() != "1\n2\n" { // The values encoded before the error are flushed.
+			t.Fatalf("got %q", buf.String())
+		}
+	})
+}
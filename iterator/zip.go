@@ -40,3 +40,16 @@ func (z *zipIterator[T, U]) Next() option.Option[pair.Pair[T, U]] {
 		},
 	)
 }
+
+// Returns the bounds on the remaining length, which is that of the shorter iterator.
+func (z *zipIterator[T, U]) SizeHint() (uint64, option.Option[uint64]) {
+	firstLower, firstUpper := SizeHint(z.first)
+	secondLower, secondUpper := SizeHint(z.second)
+	return min(firstLower, secondLower), minUpper(firstUpper, secondUpper)
+}
+
+// Close closes both zipped iterators, for those that implement Closer.
+func (z *zipIterator[T, U]) Close() {
+	closeIter(z.first)
+	closeIter(z.second)
+}
@@ -0,0 +1,76 @@
+package iterator
+
+import (
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/pair"
+)
+
+type zipLongestIterator[T any, U any] struct {
+	first      Iterator[T]
+	second     Iterator[U]
+	firstDone  bool // Whether first has returned Nothing.
+	secondDone bool // Whether second has returned Nothing.
+}
+
+// Zips up two iterators into a single iterator of pairs, continuing until both are exhausted.
+// Once the shorter iterator is exhausted, its side of each pair is Nothing.
+// Unlike Zip, neither iterator is consulted again after it first returns Nothing.
+func ZipLongest[T any, U any](first Iterator[T], second Iterator[U]) *zipLongestIterator[T, U] {
+	return &zipLongestIterator[T, U]{
+		first:      first,
+		second:     second,
+		firstDone:  false,
+		secondDone: false,
+	}
+}
+
+// Returns the next pair from the zipped-up iterators.
+// Returns Nothing once both iterators have returned Nothing.
+func (z *zipLongestIterator[T, U]) Next() option.Option[pair.Pair[option.Option[T], option.Option[U]]] {
+	valFirst := option.Nothing[T]()
+	if !z.firstDone {
+		valFirst = z.first.Next()
+		z.firstDone = valFirst.IsNothing()
+	}
+	valSecond := option.Nothing[U]()
+	if !z.secondDone {
+		valSecond = z.second.Next()
+		z.secondDone = valSecond.IsNothing()
+	}
+	if valFirst.IsNothing() && valSecond.IsNothing() {
+		return option.Nothing[pair.Pair[option.Option[T], option.Option[U]]]()
+	}
+	return option.Some(
+		pair.Pair[option.Option[T], option.Option[U]]{
+			First:  valFirst,
+			Second: valSecond,
+		},
+	)
+}
+
+// Returns the bounds on the remaining length, which is that of the longer iterator.
+func (z *zipLongestIterator[T, U]) SizeHint() (uint64, option.Option[uint64]) {
+	firstLower, firstUpper := uint64(0), option.Some[uint64](0)
+	if !z.firstDone {
+		firstLower, firstUpper = SizeHint(z.first)
+	}
+	secondLower, secondUpper := uint64(0), option.Some[uint64](0)
+	if !z.secondDone {
+		secondLower, secondUpper = SizeHint(z.second)
+	}
+	upper := option.Nothing[uint64]()
+	if a, ok := firstUpper.Get(); ok {
+		if b, ok := secondUpper.Get(); ok {
+			upper = option.Some(max(a, b))
+		}
+	}
+	return max(firstLower, secondLower), upper
+}
+
+// Close closes both zipped iterators, for those that implement Closer.
+func (z *zipLongestIterator[T, U]) Close() {
+	closeIter(z.first)
+	closeIter(z.second)
+	z.firstDone = true
+	z.secondDone = true
+}
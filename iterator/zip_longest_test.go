@@ -0,0 +1,42 @@
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sidkurella/goption/iterator"
+	"github.com/sidkurella/goption/option"
+	"github.com/sidkurella/goption/pair"
+	"github.com/sidkurella/goption/sliceutil"
+)
+
+func TestZipLongest(t *testing.T) {
+	t.Run("first longer", func(t *testing.T) {
+		iter := iterator.ZipLongest[int, string](sliceutil.Iter([]int{1, 2, 3}), sliceutil.Iter([]string{"a"}))
+		expected := []pair.Pair[option.Option[int], option.Option[string]]{
+			{First: option.Some(1), Second: option.Some("a")},
+			{First: option.Some(2), Second: option.Nothing[string]()},
+			{First: option.Some(3), Second: option.Nothing[string]()},
+		}
+		if !reflect.DeepEqual(iterator.Collect(iter), expected) {
+			t.Fail()
+		}
+	})
+	t.Run("second longer", func(t *testing.T) {
+		iter := iterator.ZipLongest[int, string](sliceutil.Iter([]int{}), sliceutil.Iter([]string{"a", "b"}))
+		expected := []pair.Pair[option.Option[int], option.Option[string]]{
+			{First: option.Nothing[int](), Second: option.Some("a")},
+			{First: option.Nothing[int](), Second: option.Some("b")},
+		}
+		if !reflect.DeepEqual(iterator.Collect(iter), expected) {
+			t.Fail()
+		}
+	})
+	t.Run("size hint", func(t *testing.T) {
+		iter := iterator.ZipLongest[int, int](iterator.Range(0, 2), iterator.Range(0, 5))
+		lower, upper := iter.SizeHint()
+		if lower != 5 || upper != option.Some[uint64](5) {
+			t.Fail()
+		}
+	})
+}
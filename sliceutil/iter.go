@@ -6,11 +6,12 @@ import (
 
 type sliceIter[T any] struct {
 	i    int // Represents the next element to return from the slice.
+	j    int // Represents one past the next element to return from the back of the slice.
 	data []T // The underlying data for this iterator.
 }
 
 func (s *sliceIter[T]) Next() option.Option[T] {
-	if s.i >= len(s.data) {
+	if s.i >= s.j {
 		return option.Nothing[T]()
 	}
 	ret := option.Some(s.data[s.i])
@@ -18,22 +19,43 @@ func (s *sliceIter[T]) Next() option.Option[T] {
 	return ret
 }
 
+// Returns the next element from the back of the slice.
+func (s *sliceIter[T]) NextBack() option.Option[T] {
+	if s.i >= s.j {
+		return option.Nothing[T]()
+	}
+	s.j--
+	return option.Some(s.data[s.j])
+}
+
+// Returns the number of elements remaining in the iterator.
+func (s *sliceIter[T]) Len() uint64 {
+	return uint64(s.j - s.i)
+}
+
+// Returns the bounds on the remaining length, which is known exactly.
+func (s *sliceIter[T]) SizeHint() (uint64, option.Option[uint64]) {
+	return s.Len(), option.Some(s.Len())
+}
+
 // Returns an iterator for the given slice.
 // The iterator iterates over elements in the slice; it will shallow-copy them.
 func Iter[T any](data []T) *sliceIter[T] {
 	return &sliceIter[T]{
 		i:    0,
+		j:    len(data),
 		data: data,
 	}
 }
 
 type sliceReverseIter[T any] struct {
 	i    int // Represents the next element to return from the slice.
+	j    int // Represents the next element to return from the back of the iterator (the front of the slice).
 	data []T // The underlying data for this iterator.
 }
 
 func (s *sliceReverseIter[T]) Next() option.Option[T] {
-	if s.i < 0 {
+	if s.i < s.j {
 		return option.Nothing[T]()
 	}
 	ret := option.Some(s.data[s.i])
@@ -41,22 +63,44 @@ func (s *sliceReverseIter[T]) Next() option.Option[T] {
 	return ret
 }
 
+// Returns the next element from the back of the iterator, which is the front of the slice.
+func (s *sliceReverseIter[T]) NextBack() option.Option[T] {
+	if s.i < s.j {
+		return option.Nothing[T]()
+	}
+	ret := option.Some(s.data[s.j])
+	s.j++
+	return ret
+}
+
+// Returns the number of elements remaining in the iterator.
+func (s *sliceReverseIter[T]) Len() uint64 {
+	return uint64(s.i - s.j + 1)
+}
+
+// Returns the bounds on the remaining length, which is known exactly.
+func (s *sliceReverseIter[T]) SizeHint() (uint64, option.Option[uint64]) {
+	return s.Len(), option.Some(s.Len())
+}
+
 // Returns an iterator ranging backwards over the slice.
 // The iterator iterates over elements in the slice; it will shallow-copy them.
 func ReverseIter[T any](data []T) *sliceReverseIter[T] {
 	return &sliceReverseIter[T]{
 		i:    len(data) - 1,
+		j:    0,
 		data: data,
 	}
 }
 
 type slicePointerIter[T any] struct {
 	i    int // Represents the next element to return from the slice.
+	j    int // Represents one past the next element to return from the back of the slice.
 	data []T // The underlying data for this iterator.
 }
 
 func (s *slicePointerIter[T]) Next() option.Option[*T] {
-	if s.i >= len(s.data) {
+	if s.i >= s.j {
 		return option.Nothing[*T]()
 	}
 	ret := option.Some(&(s.data[s.i]))
@@ -64,22 +108,43 @@ func (s *slicePointerIter[T]) Next() option.Option[*T] {
 	return ret
 }
 
+// Returns a pointer to the next element from the back of the slice.
+func (s *slicePointerIter[T]) NextBack() option.Option[*T] {
+	if s.i >= s.j {
+		return option.Nothing[*T]()
+	}
+	s.j--
+	return option.Some(&(s.data[s.j]))
+}
+
+// Returns the number of elements remaining in the iterator.
+func (s *slicePointerIter[T]) Len() uint64 {
+	return uint64(s.j - s.i)
+}
+
+// Returns the bounds on the remaining length, which is known exactly.
+func (s *slicePointerIter[T]) SizeHint() (uint64, option.Option[uint64]) {
+	return s.Len(), option.Some(s.Len())
+}
+
 // Returns an iterator for the given slice.
 // The iterator iterates over pointers to elements in the slice.
 func PointerIter[T any](data []T) *slicePointerIter[T] {
 	return &slicePointerIter[T]{
 		i:    0,
+		j:    len(data),
 		data: data,
 	}
 }
 
 type sliceReversePointerIter[T any] struct {
 	i    int // Represents the next element to return from the slice.
+	j    int // Represents the next element to return from the back of the iterator (the front of the slice).
 	data []T // The underlying data for this iterator.
 }
 
 func (s *sliceReversePointerIter[T]) Next() option.Option[*T] {
-	if s.i < 0 {
+	if s.i < s.j {
 		return option.Nothing[*T]()
 	}
 	ret := option.Some(&(s.data[s.i]))
@@ -87,11 +152,32 @@ func (s *sliceReversePointerIter[T]) Next() option.Option[*T] {
 	return ret
 }
 
+// Returns a pointer to the next element from the back of the iterator, which is the front of the slice.
+func (s *sliceReversePointerIter[T]) NextBack() option.Option[*T] {
+	if s.i < s.j {
+		return option.Nothing[*T]()
+	}
+	ret := option.Some(&(s.data[s.j]))
+	s.j++
+	return ret
+}
+
+// Returns the number of elements remaining in the iterator.
+func (s *sliceReversePointerIter[T]) Len() uint64 {
+	return uint64(s.i - s.j + 1)
+}
+
+// Returns the bounds on the remaining length, which is known exactly.
+func (s *sliceReversePointerIter[T]) SizeHint() (uint64, option.Option[uint64]) {
+	return s.Len(), option.Some(s.Len())
+}
+
 // Returns an iterator ranging backwards over the slice.
 // The iterator iterates over pointers to elements in the slice.
 func ReversePointerIter[T any](data []T) *sliceReversePointerIter[T] {
 	return &sliceReversePointerIter[T]{
 		i:    len(data) - 1,
+		j:    0,
 		data: data,
 	}
 }
@@ -162,3 +162,48 @@ func TestReversePointerIter(t *testing.T) {
 		}
 	})
 }
+
+func TestIter_NextBack(t *testing.T) {
+	iter := sliceutil.Iter([]int{1, 2, 3})
+	if iter.NextBack() != option.Some(3) {
+		t.Fail()
+	}
+	if iter.Next() != option.Some(1) {
+		t.Fail()
+	}
+	if iter.NextBack() != option.Some(2) {
+		t.Fail()
+	}
+	if iter.Next().IsSome() || iter.NextBack().IsSome() {
+		t.Fail()
+	}
+}
+
+func TestReverseIter_NextBack(t *testing.T) {
+	iter := sliceutil.ReverseIter([]int{1, 2, 3})
+	if iter.NextBack() != option.Some(1) {
+		t.Fail()
+	}
+	if iter.Next() != option.Some(3) {
+		t.Fail()
+	}
+	if iter.NextBack() != option.Some(2) {
+		t.Fail()
+	}
+	if iter.Next().IsSome() || iter.NextBack().IsSome() {
+		t.Fail()
+	}
+}
+
+func TestIter_Len(t *testing.T) {
+	iter := sliceutil.Iter([]int{1, 2, 3})
+	iter.Next()
+	if iter.Len() != 2 {
+		t.Fail()
+	}
+	rev := sliceutil.ReverseIter([]int{1, 2, 3})
+	rev.NextBack()
+	if rev.Len() != 2 {
+		t.Fail()
+	}
+}
@@ -4,7 +4,8 @@ import "github.com/sidkurella/goption/option"
 
 type stringIter struct {
 	s []rune
-	i int
+	i int // Represents the next rune to return.
+	j int // Represents one past the next rune to return from the back.
 }
 
 // Returns an iterator of runes in the string.
@@ -12,14 +13,16 @@ type stringIter struct {
 // This will return each code point, not each individual byte.
 // If you wish to iterate by raw bytes, use ByteIter() instead.
 func Iter(s string) *stringIter {
+	runes := []rune(s)
 	return &stringIter{
-		s: []rune(s),
+		s: runes,
 		i: 0,
+		j: len(runes),
 	}
 }
 
 func (s *stringIter) Next() option.Option[rune] {
-	if s.i >= len(s.s) {
+	if s.i >= s.j {
 		return option.Nothing[rune]()
 	}
 	ret := option.Some(s.s[s.i])
@@ -27,9 +30,29 @@ func (s *stringIter) Next() option.Option[rune] {
 	return ret
 }
 
+// Returns the next rune from the back of the string.
+func (s *stringIter) NextBack() option.Option[rune] {
+	if s.i >= s.j {
+		return option.Nothing[rune]()
+	}
+	s.j--
+	return option.Some(s.s[s.j])
+}
+
+// Returns the number of elements remaining in the iterator.
+func (s *stringIter) Len() uint64 {
+	return uint64(s.j - s.i)
+}
+
+// Returns the bounds on the remaining length, which is known exactly.
+func (s *stringIter) SizeHint() (uint64, option.Option[uint64]) {
+	return s.Len(), option.Some(s.Len())
+}
+
 type stringByteIter struct {
 	s string
-	i int
+	i int // Represents the next byte to return.
+	j int // Represents one past the next byte to return from the back.
 }
 
 // Returns an iterator of bytes in the string.
@@ -40,14 +63,34 @@ func ByteIter(s string) *stringByteIter {
 	return &stringByteIter{
 		s: s,
 		i: 0,
+		j: len(s),
 	}
 }
 
 func (s *stringByteIter) Next() option.Option[byte] {
-	if s.i >= len(s.s) {
+	if s.i >= s.j {
 		return option.Nothing[byte]()
 	}
 	ret := option.Some(s.s[s.i])
 	s.i++
 	return ret
 }
+
+// Returns the next byte from the back of the string.
+func (s *stringByteIter) NextBack() option.Option[byte] {
+	if s.i >= s.j {
+		return option.Nothing[byte]()
+	}
+	s.j--
+	return option.Some(s.s[s.j])
+}
+
+// Returns the number of elements remaining in the iterator.
+func (s *stringByteIter) Len() uint64 {
+	return uint64(s.j - s.i)
+}
+
+// Returns the bounds on the remaining length, which is known exactly.
+func (s *stringByteIter) SizeHint() (uint64, option.Option[uint64]) {
+	return s.Len(), option.Some(s.Len())
+}
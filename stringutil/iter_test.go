@@ -86,3 +86,22 @@ func TestByteIter(t *testing.T) {
 		}
 	})
 }
+
+func TestIter_NextBack(t *testing.T) {
+	iter := stringutil.Iter("1£€3")
+	if iter.NextBack().Unwrap() != '3' {
+		t.Fail()
+	}
+	if iter.Next().Unwrap() != '1' {
+		t.Fail()
+	}
+	if iter.NextBack().Unwrap() != '€' {
+		t.Fail()
+	}
+	if iter.NextBack().Unwrap() != '£' {
+		t.Fail()
+	}
+	if !iter.Next().IsNothing() || !iter.NextBack().IsNothing() {
+		t.Fail()
+	}
+}